@@ -0,0 +1,219 @@
+package cloapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies the bearer token for each request, in the spirit of
+// oauth2.TokenSource. Pass one with WithTokenSource to rotate tokens without
+// rebuilding the client.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenInvalidator is implemented by token sources that cache. After a 401 the
+// client calls Invalidate, fetches a fresh token and retries the request once.
+type TokenInvalidator interface {
+	Invalidate()
+}
+
+// DefaultTokenEnv is the environment variable EnvToken reads by default.
+const DefaultTokenEnv = "CLO_TOKEN"
+
+type staticToken string
+
+func (t staticToken) Token(context.Context) (string, error) { return string(t), nil }
+
+// StaticToken returns a TokenSource that always yields token. New(token) uses it.
+func StaticToken(token string) TokenSource {
+	return staticToken(token)
+}
+
+type envToken string
+
+func (e envToken) Token(context.Context) (string, error) {
+	tok := strings.TrimSpace(os.Getenv(string(e)))
+	if tok == "" {
+		return "", fmt.Errorf("cloapi: environment variable %s is not set", string(e))
+	}
+	return tok, nil
+}
+
+// EnvToken returns a TokenSource reading the named environment variable on every
+// request (DefaultTokenEnv if name is empty).
+func EnvToken(name string) TokenSource {
+	if name == "" {
+		name = DefaultTokenEnv
+	}
+	return envToken(name)
+}
+
+// fileToken re-reads its file whenever the modification time or size changes,
+// which is how Kubernetes swaps a mounted secret.
+type fileToken struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// FileToken returns a TokenSource reading the token from path, trimmed of
+// surrounding whitespace. The file is re-read when it changes.
+func FileToken(path string) TokenSource {
+	return &fileToken{path: path}
+}
+
+func (f *fileToken) Token(context.Context) (string, error) {
+	fi, err := os.Stat(f.path)
+	if err != nil {
+		return "", fmt.Errorf("cloapi: token file: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.token != "" && fi.ModTime().Equal(f.modTime) && fi.Size() == f.size {
+		return f.token, nil
+	}
+	b, err := os.ReadFile(f.path)
+	if err != nil {
+		return "", fmt.Errorf("cloapi: token file: %w", err)
+	}
+	tok := strings.TrimSpace(string(b))
+	if tok == "" {
+		return "", fmt.Errorf("cloapi: token file %s is empty", f.path)
+	}
+	f.token, f.modTime, f.size = tok, fi.ModTime(), fi.Size()
+	return tok, nil
+}
+
+// Invalidate forces a re-read, for files rewritten within the mtime granularity.
+func (f *fileToken) Invalidate() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.token = ""
+}
+
+// execTokenExpirySkew refreshes exec tokens this long before they expire, so a
+// token never lapses in flight.
+const execTokenExpirySkew = 30 * time.Second
+
+// execToken runs a credential helper and caches its output until expiry.
+type execToken struct {
+	name string
+	args []string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// ExecToken returns a TokenSource that runs a credential helper command. The
+// command prints either the bare token, or JSON of the form
+//
+//	{"token": "...", "expiry": "2026-01-02T15:04:05Z"}
+//
+// The token is cached until expiry (indefinitely without one) or until the API
+// rejects it with a 401.
+func ExecToken(name string, args ...string) TokenSource {
+	return &execToken{name: name, args: args}
+}
+
+func (e *execToken) Token(ctx context.Context) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.token != "" && (e.expiry.IsZero() || time.Now().Add(execTokenExpirySkew).Before(e.expiry)) {
+		return e.token, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.name, e.args...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("cloapi: token command %s: %w: %s", e.name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	out := bytes.TrimSpace(stdout.Bytes())
+	tok, expiry := string(out), time.Time{}
+	if bytes.HasPrefix(out, []byte("{")) {
+		var cred struct {
+			Token  string    `json:"token"`
+			Expiry time.Time `json:"expiry"`
+		}
+		if err := json.Unmarshal(out, &cred); err != nil {
+			return "", fmt.Errorf("cloapi: token command %s: %w", e.name, err)
+		}
+		tok, expiry = cred.Token, cred.Expiry
+	}
+	if tok == "" {
+		return "", fmt.Errorf("cloapi: token command %s printed no token", e.name)
+	}
+	e.token, e.expiry = tok, expiry
+	return tok, nil
+}
+
+func (e *execToken) Invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.token = ""
+}
+
+// authRoundTripper sets the bearer token from a TokenSource. On a 401 from a
+// source that can refresh, it retries once with a fresh token; the request was
+// rejected before any effect, so this is safe for writes too.
+type authRoundTripper struct {
+	Proxied http.RoundTripper
+	Source  TokenSource
+}
+
+func (a *authRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	tok, err := a.Source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.Proxied.RoundTrip(withBearer(req, tok))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	inv, ok := a.Source.(TokenInvalidator)
+	if !ok {
+		return resp, nil
+	}
+	inv.Invalidate()
+	fresh, err := a.Source.Token(req.Context())
+	if err != nil || fresh == tok {
+		return resp, nil
+	}
+	retry := withBearer(req, fresh)
+	if req.Body != nil {
+		if req.GetBody == nil {
+			return resp, nil
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	drainAndClose(resp)
+	return a.Proxied.RoundTrip(retry)
+}
+
+// withBearer returns a shallow copy of req carrying the token; RoundTrippers must
+// not modify the request they are given.
+func withBearer(req *http.Request, token string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
@@ -0,0 +1,166 @@
+package cloapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEnvToken(t *testing.T) {
+	t.Setenv("CLO_TEST_TOKEN", " tok-env \n")
+	tok, err := EnvToken("CLO_TEST_TOKEN").Token(context.Background())
+	if err != nil || tok != "tok-env" {
+		t.Fatalf("tok = %q, err = %v", tok, err)
+	}
+	t.Setenv("CLO_TEST_TOKEN", "")
+	if _, err := EnvToken("CLO_TEST_TOKEN").Token(context.Background()); err == nil {
+		t.Fatal("want error for an unset variable")
+	}
+}
+
+func TestFileTokenRereadsOnChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ts := FileToken(path)
+	ctx := context.Background()
+	if tok, _ := ts.Token(ctx); tok != "first" {
+		t.Fatalf("tok = %q", tok)
+	}
+
+	if err := os.WriteFile(path, []byte("second-token"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// Make the change visible even on filesystems with coarse mtimes.
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatal(err)
+	}
+	if tok, _ := ts.Token(ctx); tok != "second-token" {
+		t.Fatalf("tok = %q after rotation", tok)
+	}
+}
+
+// writeScript creates an executable shell script for ExecToken tests.
+func writeScript(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "cred.sh")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestExecTokenCachesUntilExpiry(t *testing.T) {
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "calls")
+	expiry := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	script := writeScript(t, `echo x >> `+counter+`
+echo '{"token":"tok-exec","expiry":"`+expiry+`"}'
+`)
+	ts := ExecToken(script)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		tok, err := ts.Token(ctx)
+		if err != nil || tok != "tok-exec" {
+			t.Fatalf("tok = %q, err = %v", tok, err)
+		}
+	}
+	calls, _ := os.ReadFile(counter)
+	if n := strings.Count(string(calls), "x"); n != 1 {
+		t.Fatalf("command ran %d times, want 1", n)
+	}
+
+	ts.(TokenInvalidator).Invalidate()
+	if _, err := ts.Token(ctx); err != nil {
+		t.Fatal(err)
+	}
+	calls, _ = os.ReadFile(counter)
+	if n := strings.Count(string(calls), "x"); n != 2 {
+		t.Fatalf("command ran %d times after Invalidate, want 2", n)
+	}
+}
+
+func TestExecTokenPlainOutputAndFailure(t *testing.T) {
+	tok, err := ExecToken(writeScript(t, "echo plain-token\n")).Token(context.Background())
+	if err != nil || tok != "plain-token" {
+		t.Fatalf("tok = %q, err = %v", tok, err)
+	}
+	if _, err := ExecToken(writeScript(t, "echo denied >&2; exit 3\n")).Token(context.Background()); err == nil ||
+		!strings.Contains(err.Error(), "denied") {
+		t.Fatalf("err = %v, want command stderr", err)
+	}
+}
+
+// rotatingSource hands out tok-1, then tok-2 after Invalidate.
+type rotatingSource struct{ gen int32 }
+
+func (r *rotatingSource) Token(context.Context) (string, error) {
+	if atomic.LoadInt32(&r.gen) == 0 {
+		return "tok-1", nil
+	}
+	return "tok-2", nil
+}
+
+func (r *rotatingSource) Invalidate() { atomic.StoreInt32(&r.gen, 1) }
+
+func TestTokenSourceRetriesOnceAfter401(t *testing.T) {
+	var hits int32
+	var lastBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.Header.Get("Authorization") != "Bearer tok-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, r.Body)
+		lastBody = buf.String()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	cli, err := New("", WithBaseURL(srv.URL), WithTokenSource(&rotatingSource{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// POST: a 401 is retried even for writes, with the body replayed.
+	if _, err := cli.ProjectCreateWithResponse(context.Background(), ProjectCreateJSONRequestBody{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&hits); got != 2 {
+		t.Errorf("hits = %d, want 2", got)
+	}
+	if lastBody != "{}" {
+		t.Errorf("replayed body = %q", lastBody)
+	}
+}
+
+func TestStaticTokenDoesNotRetry401(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	cli, err := New("bad", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.AccountBalanceWithResponse(context.Background()); !HasStatus(err, http.StatusUnauthorized) {
+		t.Fatalf("err = %v, want 401", err)
+	}
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("hits = %d, want 1", got)
+	}
+}
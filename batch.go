@@ -0,0 +1,177 @@
+package cloapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// defaultBatchConcurrency is used when BatchOptions.Concurrency is not set.
+const defaultBatchConcurrency = 8
+
+// BatchOptions tunes Batch.
+type BatchOptions struct {
+	// Concurrency is the number of items in flight at once (default 8). Requests
+	// still pass through the client's transport, so WithRateLimit applies on top.
+	Concurrency int
+	// FailFast stops at the first failure: items not yet started are skipped and
+	// those in flight are cancelled. By default every item is attempted.
+	FailFast bool
+	// Progress, if set, is called after each item finishes. Calls are serialised.
+	Progress func(BatchProgress)
+}
+
+// BatchProgress is reported to BatchOptions.Progress after each item.
+type BatchProgress struct {
+	// ID and Err describe the item that just finished.
+	ID  string
+	Err error
+	// Done counts finished items, Failed those among them that failed.
+	Done, Failed, Total int
+}
+
+// BatchResult is the outcome of one item.
+type BatchResult[T any] struct {
+	ID    string
+	Value T
+	Err   error
+	// Skipped is set for items never started, because a FailFast batch stopped
+	// or ctx was cancelled.
+	Skipped bool
+}
+
+// BatchItemError is the failure of one item of a batch.
+type BatchItemError struct {
+	ID  string
+	Err error
+}
+
+func (e *BatchItemError) Error() string { return e.ID + ": " + e.Err.Error() }
+
+func (e *BatchItemError) Unwrap() error { return e.Err }
+
+// BatchError collects the failed items of a batch. Like errors.Join it unwraps to
+// every item error, so errors.Is and errors.As see through it.
+type BatchError struct {
+	Items []*BatchItemError
+	// Total is the number of items in the batch.
+	Total int
+}
+
+func (e *BatchError) Error() string {
+	msg := fmt.Sprintf("cloapi: %d of %d batch items failed", len(e.Items), e.Total)
+	for _, it := range e.Items {
+		msg += "\n" + it.Error()
+	}
+	return msg
+}
+
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Items))
+	for i, it := range e.Items {
+		errs[i] = it
+	}
+	return errs
+}
+
+// Err returns the error of item id, or nil if it did not fail.
+func (e *BatchError) Err(id string) error {
+	for _, it := range e.Items {
+		if it.ID == id {
+			return it.Err
+		}
+	}
+	return nil
+}
+
+// ApiError returns the API error of item id, if it failed with one.
+func (e *BatchError) ApiError(id string) (*ApiError, bool) {
+	return AsApiError(e.Err(id))
+}
+
+// Batch runs op for every ID with bounded concurrency and returns the results in
+// the order of ids. The error is a *BatchError if any item failed:
+//
+//	results, err := cloapi.Batch(ctx, serverIDs, func(ctx context.Context, id string) (*cloapi.ServerStopResponse, error) {
+//		return cli.ServerStopWithResponse(ctx, id)
+//	}, cloapi.BatchOptions{Concurrency: 16})
+//	var batchErr *cloapi.BatchError
+//	if errors.As(err, &batchErr) {
+//		for _, it := range batchErr.Items { log.Printf("%s: %v", it.ID, it.Err) }
+//	}
+//
+// If ctx is cancelled, unstarted items are skipped as with FailFast, and ctx's
+// error is returned if no item failed.
+func Batch[T any](ctx context.Context, ids []string, op func(ctx context.Context, id string) (T, error), opts BatchOptions) ([]BatchResult[T], error) {
+	results := make([]BatchResult[T], len(ids))
+	for i, id := range ids {
+		results[i] = BatchResult[T]{ID: id, Skipped: true}
+	}
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = defaultBatchConcurrency
+	}
+	workers = min(workers, len(ids))
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu           sync.Mutex
+		done, failed int
+		next         = make(chan int)
+		wg           sync.WaitGroup
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				v, err := op(ctx, ids[i])
+
+				mu.Lock()
+				results[i] = BatchResult[T]{ID: ids[i], Value: v, Err: err}
+				done++
+				if err != nil {
+					failed++
+					if opts.FailFast {
+						cancel()
+					}
+				}
+				if opts.Progress != nil {
+					opts.Progress(BatchProgress{ID: ids[i], Err: err, Done: done, Failed: failed, Total: len(ids)})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for i := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	batchErr := &BatchError{Total: len(ids)}
+	for _, r := range results {
+		if r.Err != nil {
+			batchErr.Items = append(batchErr.Items, &BatchItemError{ID: r.ID, Err: r.Err})
+		}
+	}
+	switch {
+	case len(batchErr.Items) > 0:
+		return results, batchErr
+	case parent.Err() != nil && len(ids) > done:
+		return results, parent.Err()
+	}
+	return results, nil
+}
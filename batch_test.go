@@ -0,0 +1,100 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func batchIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("id-%d", i)
+	}
+	return ids
+}
+
+func TestBatchBestEffort(t *testing.T) {
+	var inFlight, peak int32
+	var progress []BatchProgress
+	results, err := Batch(context.Background(), batchIDs(20), func(ctx context.Context, id string) (string, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		if id == "id-3" || id == "id-7" {
+			return "", &ApiError{Code: http.StatusConflict, Message: "busy"}
+		}
+		return strings.ToUpper(id), nil
+	}, BatchOptions{Concurrency: 4, Progress: func(p BatchProgress) { progress = append(progress, p) }})
+
+	if peak > 4 {
+		t.Errorf("peak concurrency = %d, want <= 4", peak)
+	}
+	if len(results) != 20 || results[5].Value != "ID-5" || results[5].ID != "id-5" {
+		t.Fatalf("results out of order: %+v", results[5])
+	}
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Items) != 2 || batchErr.Total != 20 {
+		t.Fatalf("err = %v", err)
+	}
+	if apiErr, ok := batchErr.ApiError("id-7"); !ok || apiErr.Code != http.StatusConflict {
+		t.Errorf("ApiError(id-7) = %v, %v", apiErr, ok)
+	}
+	if batchErr.Err("id-5") != nil {
+		t.Error("id-5 did not fail")
+	}
+	if !HasStatus(err, http.StatusConflict) {
+		t.Error("errors.As should reach the item ApiError through the batch error")
+	}
+	if last := progress[len(progress)-1]; len(progress) != 20 || last.Done != 20 || last.Failed != 2 || last.Total != 20 {
+		t.Errorf("progress = %d calls, last %+v", len(progress), last)
+	}
+}
+
+func TestBatchFailFast(t *testing.T) {
+	var started int32
+	results, err := Batch(context.Background(), batchIDs(50), func(ctx context.Context, id string) (int, error) {
+		atomic.AddInt32(&started, 1)
+		if id == "id-0" {
+			return 0, errors.New("fatal")
+		}
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+			return 1, nil
+		}
+	}, BatchOptions{Concurrency: 2, FailFast: true})
+	if err == nil {
+		t.Fatal("want error")
+	}
+	if n := atomic.LoadInt32(&started); n > 4 {
+		t.Errorf("%d items started after a fail-fast failure", n)
+	}
+	if !results[49].Skipped {
+		t.Error("unstarted items should be marked skipped")
+	}
+}
+
+func TestBatchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err := Batch(ctx, batchIDs(3), func(context.Context, string) (int, error) { return 1, nil }, BatchOptions{})
+	if !errors.Is(err, context.Canceled) || !results[0].Skipped {
+		t.Fatalf("err = %v, results = %+v", err, results)
+	}
+	if _, err := Batch(context.Background(), nil, func(context.Context, string) (int, error) { return 1, nil }, BatchOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,384 @@
+package cloapi
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTLs returns TTLs for the catalog endpoints that change rarely but
+// are read constantly. The map is fresh on every call, so it may be edited.
+func DefaultCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		"/v2/projects/{project_id}/images":                    time.Hour,
+		"/v2/projects/{project_id}/recipes":                   time.Hour,
+		"/v2/projects/{project_id}/dbaas/datastores":          time.Hour,
+		"/v2/projects/{project_id}/params":                    time.Hour,
+		"/v2/projects/{project_id}/servers/related-resources": time.Hour,
+		"/v2/projects/{project_id}/dbaas/related-resources":   time.Hour,
+		"/v2/licenses": time.Hour,
+	}
+}
+
+// Key prefixes of the caching and conditional-request transports in a CacheStore.
+const (
+	cacheKeyPrefix = "cache:"
+	condKeyPrefix  = "cond:"
+)
+
+// CacheConfig controls the caching transport.
+type CacheConfig struct {
+	// TTLs maps path templates, with {name} standing for one path segment, to how
+	// long GET responses of matching paths are kept. Other paths are not cached.
+	// Defaults to DefaultCacheTTLs.
+	TTLs map[string]time.Duration
+	// Store holds the entries. Defaults to NewMemoryCache(1000).
+	Store CacheStore
+	// StaleWhileRevalidate serves an entry for up to this long past its TTL while
+	// refreshing it in the background, so callers never wait on a refresh.
+	StaleWhileRevalidate time.Duration
+}
+
+// CacheEntry is a stored GET response.
+type CacheEntry struct {
+	StatusCode int         `json:"status"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	Expires    time.Time   `json:"expires"`
+}
+
+// CacheStore holds cached responses by key. Implementations must be safe for
+// concurrent use. The caching and conditional-request transports prefix their
+// keys with "cache:" and "cond:", so both can share one store.
+type CacheStore interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, e *CacheEntry)
+	// DeleteFunc removes every entry whose key match reports true for.
+	DeleteFunc(match func(key string) bool)
+}
+
+// memoryCache is an in-memory LRU CacheStore.
+type memoryCache struct {
+	mu    sync.Mutex
+	max   int
+	order *list.List // front is most recently used; values are *memoryItem
+	items map[string]*list.Element
+}
+
+type memoryItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCache returns an in-memory CacheStore holding at most maxEntries
+// responses, evicting the least recently used.
+func NewMemoryCache(maxEntries int) CacheStore {
+	return &memoryCache{max: max(maxEntries, 1), order: list.New(), items: map[string]*list.Element{}}
+}
+
+func (m *memoryCache) Get(key string) (*CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	el, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+	m.order.MoveToFront(el)
+	return el.Value.(*memoryItem).entry, true
+}
+
+func (m *memoryCache) Set(key string, e *CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.items[key]; ok {
+		el.Value.(*memoryItem).entry = e
+		m.order.MoveToFront(el)
+		return
+	}
+	m.items[key] = m.order.PushFront(&memoryItem{key: key, entry: e})
+	for m.order.Len() > m.max {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.items, oldest.Value.(*memoryItem).key)
+	}
+}
+
+func (m *memoryCache) DeleteFunc(match func(string) bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, el := range m.items {
+		if match(key) {
+			m.order.Remove(el)
+			delete(m.items, key)
+		}
+	}
+}
+
+// diskCache is a CacheStore keeping one JSON file per entry in a directory.
+type diskCache struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// diskEntry is the file format of diskCache.
+type diskEntry struct {
+	Key string `json:"key"`
+	CacheEntry
+}
+
+// NewDiskCache returns a CacheStore keeping entries as files in dir, created
+// with 0700 permissions if missing, so the cache survives restarts. It does not
+// bound its size. Responses are account data: do not share dir between accounts.
+func NewDiskCache(dir string) (CacheStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &diskCache{dir: dir}, nil
+}
+
+func (d *diskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (d *diskCache) Get(key string) (*CacheEntry, bool) {
+	b, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var e diskEntry
+	if json.Unmarshal(b, &e) != nil || e.Key != key {
+		return nil, false
+	}
+	return &e.CacheEntry, true
+}
+
+func (d *diskCache) Set(key string, e *CacheEntry) {
+	b, err := json.Marshal(diskEntry{Key: key, CacheEntry: *e})
+	if err != nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// Write then rename, so readers never see a partial file.
+	tmp, err := os.CreateTemp(d.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, werr := tmp.Write(b)
+	cerr := tmp.Close()
+	if werr != nil || cerr != nil || os.Rename(tmp.Name(), d.path(key)) != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+func (d *diskCache) DeleteFunc(match func(string) bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	files, _ := filepath.Glob(filepath.Join(d.dir, "*.json"))
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			continue
+		}
+		var e struct {
+			Key string `json:"key"`
+		}
+		if json.Unmarshal(b, &e) != nil || match(e.Key) {
+			_ = os.Remove(f)
+		}
+	}
+}
+
+// cacheBypassHeader marks a request for WithCacheBypass. The cache strips it
+// before the request leaves the client.
+const cacheBypassHeader = "X-Cloapi-Cache-Bypass"
+
+// WithCacheBypass makes a GET skip the cache and fetch a fresh response, which
+// then replaces the cached one:
+//
+//	resp, err := cli.ProjectImagesListWithResponse(ctx, projectID, cloapi.WithCacheBypass())
+func WithCacheBypass() RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set(cacheBypassHeader, "1")
+		return nil
+	}
+}
+
+// cacheRoundTripper serves GETs of configured paths from a CacheStore and drops
+// a project's entries after a successful mutation under it.
+type cacheRoundTripper struct {
+	Proxied http.RoundTripper
+	Config  CacheConfig
+
+	mu         sync.Mutex
+	refreshing map[string]bool
+}
+
+func newCacheRoundTripper(proxied http.RoundTripper, cfg CacheConfig) *cacheRoundTripper {
+	if cfg.TTLs == nil {
+		cfg.TTLs = DefaultCacheTTLs()
+	}
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryCache(1000)
+	}
+	return &cacheRoundTripper{Proxied: proxied, Config: cfg, refreshing: map[string]bool{}}
+}
+
+func (c *cacheRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	bypass := req.Header.Get(cacheBypassHeader) != ""
+	if bypass {
+		req = req.Clone(req.Context())
+		req.Header.Del(cacheBypassHeader)
+	}
+
+	if req.Method != http.MethodGet {
+		resp, err := c.Proxied.RoundTrip(req)
+		if err == nil && resp.StatusCode < 300 && req.Method != http.MethodHead {
+			c.invalidateProject(req.URL)
+		}
+		return resp, err
+	}
+
+	ttl, ok := c.ttl(req.URL.Path)
+	if !ok {
+		return c.Proxied.RoundTrip(req)
+	}
+	key := cacheKeyPrefix + req.URL.String()
+	if !bypass {
+		if e, ok := c.Config.Store.Get(key); ok {
+			now := time.Now()
+			switch {
+			case now.Before(e.Expires):
+				return e.response(req), nil
+			case now.Before(e.Expires.Add(c.Config.StaleWhileRevalidate)):
+				c.revalidate(req, key, ttl)
+				return e.response(req), nil
+			}
+		}
+	}
+	return c.fetch(req, key, ttl)
+}
+
+// fetch sends req and stores a 200 response.
+func (c *cacheRoundTripper) fetch(req *http.Request, key string, ttl time.Duration) (*http.Response, error) {
+	resp, err := c.Proxied.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	e := &CacheEntry{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: body, Expires: time.Now().Add(ttl)}
+	c.Config.Store.Set(key, e)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// revalidate refreshes key in the background, once at a time per key.
+func (c *cacheRoundTripper) revalidate(req *http.Request, key string, ttl time.Duration) {
+	c.mu.Lock()
+	if c.refreshing[key] {
+		c.mu.Unlock()
+		return
+	}
+	c.refreshing[key] = true
+	c.mu.Unlock()
+
+	bg := req.Clone(context.WithoutCancel(req.Context()))
+	go func() {
+		defer func() {
+			c.mu.Lock()
+			delete(c.refreshing, key)
+			c.mu.Unlock()
+		}()
+		if resp, err := c.fetch(bg, key, ttl); err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+}
+
+// ttl returns the TTL of a template matching path. Templates should not overlap.
+func (c *cacheRoundTripper) ttl(path string) (time.Duration, bool) {
+	for tmpl, ttl := range c.Config.TTLs {
+		if matchPathTemplate(tmpl, path) {
+			return ttl, true
+		}
+	}
+	return 0, false
+}
+
+// invalidateProject drops cached entries of the project a mutation touched.
+// Mutations outside /v2/projects/{id} cannot be tied to a project and leave the
+// cache alone.
+func (c *cacheRoundTripper) invalidateProject(u *url.URL) {
+	project := projectOfPath(u.Path)
+	if project == "" {
+		return
+	}
+	c.Config.Store.DeleteFunc(func(key string) bool {
+		raw, ok := strings.CutPrefix(key, cacheKeyPrefix)
+		if !ok {
+			return false // another transport's entry
+		}
+		k, err := url.Parse(raw)
+		return err != nil || (k.Host == u.Host && projectOfPath(k.Path) == project)
+	})
+}
+
+func (e *CacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// matchPathTemplate reports whether path matches tmpl, where a {name} segment
+// matches any one non-empty segment.
+func matchPathTemplate(tmpl, path string) bool {
+	ts, ps := strings.Split(strings.Trim(tmpl, "/"), "/"), strings.Split(strings.Trim(path, "/"), "/")
+	if len(ts) != len(ps) {
+		return false
+	}
+	for i, t := range ts {
+		if strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}") {
+			if ps[i] == "" {
+				return false
+			}
+		} else if t != ps[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// projectOfPath returns the project ID of a /v2/projects/{id}/... path, or "".
+func projectOfPath(path string) string {
+	rest, ok := strings.CutPrefix(path, "/v2/projects/")
+	if !ok {
+		return ""
+	}
+	project, _, _ := strings.Cut(rest, "/")
+	return project
+}
@@ -0,0 +1,169 @@
+package cloapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingServer answers every request with its running hit count for the path.
+type countingServer struct {
+	mu   sync.Mutex
+	hits map[string]int
+}
+
+func (s *countingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.hits[r.Method+" "+r.URL.Path]++
+	s.mu.Unlock()
+	if r.Header.Get(cacheBypassHeader) != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodPost {
+		_, _ = w.Write([]byte(`{"result":{"id":"new"}}`))
+		return
+	}
+	_, _ = w.Write([]byte(`{"count":0,"result":[]}`))
+}
+
+func (s *countingServer) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hits[key]
+}
+
+func newCacheClient(t *testing.T, cfg CacheConfig) (*ClientWithResponses, *countingServer) {
+	t.Helper()
+	fake := &countingServer{hits: map[string]int{}}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	cli, err := New("tok", WithBaseURL(srv.URL), WithCache(cfg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli, fake
+}
+
+func TestCacheServesWithinTTL(t *testing.T) {
+	cli, fake := newCacheClient(t, CacheConfig{})
+	ctx := context.Background()
+	for range 3 {
+		resp, err := cli.ProjectImagesListWithResponse(ctx, "p1")
+		if err != nil || resp.OK == nil {
+			t.Fatalf("resp = %+v, err = %v", resp, err)
+		}
+	}
+	if n := fake.count("GET /v2/projects/p1/images"); n != 1 {
+		t.Errorf("upstream hits = %d, want 1", n)
+	}
+
+	// Uncached paths always go upstream.
+	_, _ = cli.ProjectServerListWithResponse(ctx, "p1")
+	_, _ = cli.ProjectServerListWithResponse(ctx, "p1")
+	if n := fake.count("GET /v2/projects/p1/servers"); n != 2 {
+		t.Errorf("server list hits = %d, want 2", n)
+	}
+
+	// The bypass editor refetches, and its marker never reaches the API.
+	if _, err := cli.ProjectImagesListWithResponse(ctx, "p1", WithCacheBypass()); err != nil {
+		t.Fatal(err)
+	}
+	if n := fake.count("GET /v2/projects/p1/images"); n != 2 {
+		t.Errorf("upstream hits after bypass = %d, want 2", n)
+	}
+}
+
+func TestCacheInvalidatesProjectOnMutation(t *testing.T) {
+	cli, fake := newCacheClient(t, CacheConfig{})
+	ctx := context.Background()
+	_, _ = cli.ProjectImagesListWithResponse(ctx, "p1")
+	_, _ = cli.ProjectImagesListWithResponse(ctx, "p2")
+
+	if _, err := cli.VolumeCreateWithResponse(ctx, "p1", VolumeCreateJSONRequestBody{Name: "v"}); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = cli.ProjectImagesListWithResponse(ctx, "p1")
+	_, _ = cli.ProjectImagesListWithResponse(ctx, "p2")
+	if n := fake.count("GET /v2/projects/p1/images"); n != 2 {
+		t.Errorf("p1 hits = %d, want a refetch after the mutation", n)
+	}
+	if n := fake.count("GET /v2/projects/p2/images"); n != 1 {
+		t.Errorf("p2 hits = %d, other projects stay cached", n)
+	}
+}
+
+func TestCacheStaleWhileRevalidate(t *testing.T) {
+	cli, fake := newCacheClient(t, CacheConfig{
+		TTLs:                 map[string]time.Duration{"/v2/projects/{id}/images": time.Millisecond},
+		StaleWhileRevalidate: time.Hour,
+	})
+	ctx := context.Background()
+	_, _ = cli.ProjectImagesListWithResponse(ctx, "p1")
+	time.Sleep(5 * time.Millisecond)
+	if _, err := cli.ProjectImagesListWithResponse(ctx, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for fake.count("GET /v2/projects/p1/images") < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if n := fake.count("GET /v2/projects/p1/images"); n != 2 {
+		t.Errorf("hits = %d, want one background refresh", n)
+	}
+}
+
+func TestMemoryCacheLRU(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", &CacheEntry{})
+	c.Set("b", &CacheEntry{})
+	c.Get("a")
+	c.Set("c", &CacheEntry{})
+	if _, ok := c.Get("b"); ok {
+		t.Error("b was least recently used and should be evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("a should survive")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := "https://api.clo.ru/v2/projects/p1/images"
+	c.Set(key, &CacheEntry{StatusCode: 200, Header: http.Header{"Content-Type": {"application/json"}}, Body: []byte(`{}`), Expires: time.Now().Add(time.Hour)})
+
+	reopened, _ := NewDiskCache(dir)
+	e, ok := reopened.Get(key)
+	if !ok || string(e.Body) != "{}" || e.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("entry = %+v, ok = %v", e, ok)
+	}
+	reopened.DeleteFunc(func(k string) bool { return strings.Contains(k, "/p1/") })
+	if _, ok := c.Get(key); ok {
+		t.Error("entry should be deleted")
+	}
+}
+
+func TestMatchPathTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		tmpl, path string
+		want       bool
+	}{
+		{"/v2/projects/{id}/images", "/v2/projects/p1/images", true},
+		{"/v2/projects/{id}/images", "/v2/projects/p1/images/i1", false},
+		{"/v2/projects/{id}/images", "/v2/projects//images", false},
+		{"/v2/licenses", "/v2/licenses", true},
+	} {
+		if got := matchPathTemplate(tc.tmpl, tc.path); got != tc.want {
+			t.Errorf("match(%q, %q) = %v", tc.tmpl, tc.path, got)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package cloapi
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// callOptions are per-call overrides of the client-wide transport settings,
+// carried in the request context. nil fields defer to the client.
+type callOptions struct {
+	timeout  *time.Duration
+	retry    *RetryConfig
+	logLevel *slog.Level
+	rawBody  *bool
+	drift    func(DriftReport)
+}
+
+type callOptionsKey struct{}
+
+func callOptionsFrom(ctx context.Context) callOptions {
+	opts, _ := ctx.Value(callOptionsKey{}).(callOptions)
+	return opts
+}
+
+func withCallOptions(ctx context.Context, set func(*callOptions)) context.Context {
+	opts := callOptionsFrom(ctx)
+	set(&opts)
+	return context.WithValue(ctx, callOptionsKey{}, opts)
+}
+
+// WithCallTimeout returns a context under which each attempt of a call uses
+// timeout instead of the client's WithTimeout, longer or shorter:
+//
+//	ctx := cloapi.WithCallTimeout(ctx, 10*time.Minute)
+//	resp, err := cli.DbaasBackupDownloadWithResponse(ctx, backupID)
+//
+// To cap the call as a whole, retries included, give ctx a deadline instead.
+// It cannot extend the Timeout of a client passed with WithHTTPClient, which the
+// http.Client enforces itself.
+func WithCallTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) { o.timeout = &timeout })
+}
+
+// WithCallRetry returns a context under which calls retry count times, spaced by
+// backoff, whatever the client's WithRetry says. As with WithRetry, only
+// idempotent methods are retried.
+func WithCallRetry(ctx context.Context, count int, backoff time.Duration) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) { o.retry = &RetryConfig{Count: count, Backoff: backoff} })
+}
+
+// WithoutRetry returns a context under which calls are never retried, e.g. for a
+// health probe that must report the first failure.
+func WithoutRetry(ctx context.Context) context.Context {
+	return WithCallRetry(ctx, 0, 0)
+}
+
+// WithCallLogLevel returns a context under which the logging transport records
+// calls at level, whatever their outcome, e.g. slog.LevelDebug to keep frequent
+// polling out of the logs.
+func WithCallLogLevel(ctx context.Context, level slog.Level) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) { o.logLevel = &level })
+}
+
+// WithCallRawBody returns a context under which the Response of a call keeps its
+// raw Body (retain true) or not, whatever the client's WithoutRawBody says:
+//
+//	ctx := cloapi.WithCallRawBody(ctx, false)
+//	resp, err := cli.AccountStatWithResponse(ctx) // resp.OK only, resp.Body is nil
+func WithCallRawBody(ctx context.Context, retain bool) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) { o.rawBody = &retain })
+}
+
+// retainBody reports whether a Parse*Response function keeps the raw body of rsp.
+// Responses not produced by this client's transport always keep it.
+func retainBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	retain := callOptionsFrom(rsp.Request.Context()).rawBody
+	return retain == nil || *retain
+}
+
+// callDefaultsRoundTripper fills in the client-wide settings that Parse*Response
+// functions read from the request context, for calls that do not choose for
+// themselves. It sits outermost, so the request every response points back to
+// carries them.
+type callDefaultsRoundTripper struct {
+	Proxied http.RoundTripper
+	RawBody *bool
+	Drift   func(DriftReport)
+}
+
+func (d *callDefaultsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	opts := callOptionsFrom(req.Context())
+	if (opts.rawBody == nil && d.RawBody != nil) || (opts.drift == nil && d.Drift != nil) {
+		req = req.WithContext(withCallOptions(req.Context(), func(o *callOptions) {
+			if o.rawBody == nil {
+				o.rawBody = d.RawBody
+			}
+			if o.drift == nil {
+				o.drift = d.Drift
+			}
+		}))
+	}
+	return d.Proxied.RoundTrip(req)
+}
+
+// timeoutRoundTripper bounds each request by the call's WithCallTimeout, or by
+// Default. It replaces http.Client.Timeout, which a single call could not extend.
+type timeoutRoundTripper struct {
+	Proxied http.RoundTripper
+	Default time.Duration
+}
+
+func (t *timeoutRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	timeout := t.Default
+	if d := callOptionsFrom(req.Context()).timeout; d != nil {
+		timeout = *d
+	}
+	if timeout <= 0 {
+		return t.Proxied.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := t.Proxied.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// The deadline also covers reading the body, as http.Client.Timeout does.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose releases a request context once its response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
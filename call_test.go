@@ -0,0 +1,128 @@
+package cloapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithCallTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(60 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	cli, err := New("tok", WithBaseURL(srv.URL), WithTimeout(20*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := cli.AccountBalanceWithResponse(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want the client timeout to fire", err)
+	}
+	if _, err := cli.AccountBalanceWithResponse(WithCallTimeout(ctx, time.Second)); err != nil {
+		t.Fatalf("a longer call timeout should let the call finish: %v", err)
+	}
+}
+
+func TestTimeoutPerAttempt(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	// Two backoffs alone outlast the timeout; each attempt stays well within it.
+	cli, err := New("tok", WithBaseURL(srv.URL), WithTimeout(100*time.Millisecond), WithRetry(2, 80*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := cli.AccountBalanceWithResponse(context.Background())
+	if err != nil {
+		t.Fatalf("retries were cut off by the timeout: %v", err)
+	}
+	if resp.StatusCode() != http.StatusOK || atomic.LoadInt32(&hits) != 3 {
+		t.Errorf("status = %d after %d hits", resp.StatusCode(), hits)
+	}
+}
+
+func TestWithCallRetry(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	cli, err := New("tok", WithBaseURL(srv.URL), WithRetry(2, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+		want int32
+	}{
+		{"client default", ctx, 3},
+		{"without retry", WithoutRetry(ctx), 1},
+		{"call retry", WithCallRetry(ctx, 4, 0), 5},
+	} {
+		atomic.StoreInt32(&hits, 0)
+		_, _ = cli.AccountBalanceWithResponse(tc.ctx)
+		if got := atomic.LoadInt32(&hits); got != tc.want {
+			t.Errorf("%s: hits = %d, want %d", tc.name, got, tc.want)
+		}
+	}
+
+	// Per-call retry works on a client built without WithRetry too.
+	plain, _ := New("tok", WithBaseURL(srv.URL))
+	atomic.StoreInt32(&hits, 0)
+	_, _ = plain.AccountBalanceWithResponse(WithCallRetry(ctx, 1, 0))
+	if got := atomic.LoadInt32(&hits); got != 2 {
+		t.Errorf("hits = %d, want 2", got)
+	}
+}
+
+func TestWithCallLogLevel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	cli, err := New("tok", WithBaseURL(srv.URL), WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = cli.AccountBalanceWithResponse(WithCallLogLevel(context.Background(), slog.LevelDebug))
+	if !strings.Contains(buf.String(), "level=DEBUG") || strings.Contains(buf.String(), "level=ERROR") {
+		t.Fatalf("log = %q, want the call at DEBUG", buf.String())
+	}
+}
+
+func TestCallOptionsCompose(t *testing.T) {
+	ctx := WithCallTimeout(WithoutRetry(context.Background()), time.Minute)
+	opts := callOptionsFrom(ctx)
+	if opts.retry == nil || opts.retry.Count != 0 || opts.timeout == nil || *opts.timeout != time.Minute {
+		t.Fatalf("opts = %+v", opts)
+	}
+	if callOptionsFrom(context.Background()).timeout != nil {
+		t.Fatal("a bare context carries no overrides")
+	}
+}
@@ -2,10 +2,11 @@ package cloapi
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 	"net/http"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 const defaultBaseURL = "https://api.clo.ru"
@@ -13,7 +14,7 @@ const defaultBaseURL = "https://api.clo.ru"
 // New builds a ClientWithResponses for the CLO API. It wires bearer auth, a logging
 // transport, and an optional retry transport, then returns the generated high-level
 // client. All ergonomics live here at the transport layer, so they apply to every
-// endpoint for free.
+// endpoint for free. token is ignored when WithTokenSource is given.
 func New(token string, opts ...Option) (*ClientWithResponses, error) {
 	cfg := &clientConfig{
 		baseURL: defaultBaseURL,
@@ -24,42 +25,100 @@ func New(token string, opts ...Option) (*ClientWithResponses, error) {
 		opt(cfg)
 	}
 
-	httpClient := cfg.httpClient
+	// A client we build gets no http.Client.Timeout: the timeout transport applies
+	// cfg.timeout instead, so WithCallTimeout can lengthen it for a single call.
+	httpClient, defaultTimeout := cfg.httpClient, time.Duration(0)
 	if httpClient == nil {
-		httpClient = &http.Client{Timeout: cfg.timeout}
+		httpClient, defaultTimeout = &http.Client{}, cfg.timeout
+	}
+
+	tokens := cfg.tokenSource
+	if tokens == nil {
+		tokens = StaticToken(token)
 	}
 
-	// Transport chain (outermost first): retry -> logging -> base.
-	base := httpClient.Transport
-	if base == nil {
-		base = http.DefaultTransport
+	// Transport chain (outermost first): call defaults -> validation -> cache ->
+	// coalesce -> maintenance -> retry -> timeout -> auth -> rate limit ->
+	// conditional -> logging -> decompress -> base. The timeout sits below retry
+	// so it bounds each attempt, not the backoff between them. The limiter sits
+	// below retry and auth so every attempt on the wire counts; logging sits
+	// between conditional and decompress so it reports 304s and compressed sizes
+	// as they crossed the wire.
+	var transport http.RoundTripper = httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if cfg.compression {
+		transport = &decompressRoundTripper{Proxied: transport}
+	}
+	transport = &LoggingRoundTripper{Proxied: transport, Logger: cfg.logger}
+	if cfg.conditional != nil {
+		transport = &conditionalRoundTripper{Proxied: transport, Store: cfg.conditional}
+	}
+	if cfg.rateLimit > 0 {
+		transport = &rateLimitRoundTripper{Proxied: transport, Limiter: rate.NewLimiter(rate.Limit(cfg.rateLimit), cfg.rateBurst)}
+	}
+	transport = &authRoundTripper{Proxied: transport, Source: tokens}
+	// Below retry and the maintenance hold, so the timeout bounds each attempt
+	// while backoff and holds are bounded by the caller's context alone.
+	transport = &timeoutRoundTripper{Proxied: transport, Default: defaultTimeout}
+	// Installed even with retry off, so WithCallRetry can enable it per call.
+	transport = &retryRoundTripper{Proxied: transport, Config: cfg.retry}
+	if cfg.maintenance != nil {
+		transport = &maintenanceRoundTripper{Proxied: transport, Config: *cfg.maintenance, Server: cfg.baseURL}
+	}
+	if cfg.coalesce {
+		transport = &coalesceRoundTripper{Proxied: transport}
+	}
+	if cfg.cache != nil {
+		transport = newCacheRoundTripper(transport, *cfg.cache)
+	}
+	if cfg.validate {
+		transport = &validateRoundTripper{Proxied: transport}
 	}
-	var transport http.RoundTripper = &LoggingRoundTripper{Proxied: base, Logger: cfg.logger}
-	if cfg.retry.Count > 0 {
-		transport = &retryRoundTripper{Proxied: transport, Config: cfg.retry}
+	if cfg.noRawBody || cfg.strict {
+		defaults := &callDefaultsRoundTripper{Proxied: transport, Drift: cfg.drift}
+		if cfg.noRawBody {
+			defaults.RawBody = new(bool)
+		}
+		if cfg.strict && defaults.Drift == nil {
+			defaults.Drift = func(r DriftReport) { r.log(cfg.logger) }
+		}
+		transport = defaults
 	}
 	httpClient.Transport = transport
 
-	return NewClientWithResponses(
-		cfg.baseURL,
-		WithHTTPClientDoer(httpClient),
-		WithRequestEditorFn(withAuthToken(token)),
-	)
-}
-
-func withAuthToken(token string) RequestEditorFn {
-	return func(_ context.Context, req *http.Request) error {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-		return nil
+	cli, err := NewClientWithResponses(cfg.baseURL, WithHTTPClientDoer(httpClient))
+	if err != nil || !cfg.verifyOnStart {
+		return cli, err
+	}
+	// Each probe is bounded by the timeout transport.
+	if _, err := Verify(context.Background(), cli); err != nil {
+		return nil, err
 	}
+	return cli, nil
 }
 
 type clientConfig struct {
-	baseURL    string
-	timeout    time.Duration
-	logger     *slog.Logger
-	httpClient *http.Client
-	retry      RetryConfig
+	baseURL     string
+	timeout     time.Duration
+	logger      *slog.Logger
+	httpClient  *http.Client
+	retry       RetryConfig
+	tokenSource TokenSource
+	rateLimit   float64
+	rateBurst   int
+
+	verifyOnStart bool
+	maintenance   *MaintenanceConfig
+	coalesce      bool
+	cache         *CacheConfig
+	compression   bool
+	conditional   CacheStore
+	noRawBody     bool
+	strict        bool
+	drift         func(DriftReport)
+	validate      bool
 }
 
 // Option configures the client. Functional options keep the constructor stable as
@@ -71,7 +130,8 @@ func WithBaseURL(u string) Option {
 	return func(c *clientConfig) { c.baseURL = u }
 }
 
-// WithTimeout sets the HTTP client timeout (ignored if WithHTTPClient is used).
+// WithTimeout sets the timeout of each attempt, covering reading the body but not
+// retry backoff or maintenance holds (ignored if WithHTTPClient is used). WithCallTimeout overrides it per call.
 func WithTimeout(t time.Duration) Option {
 	return func(c *clientConfig) { c.timeout = t }
 }
@@ -92,3 +152,98 @@ func WithHTTPClient(client *http.Client) Option {
 func WithRetry(count int, backoff time.Duration) Option {
 	return func(c *clientConfig) { c.retry = RetryConfig{Count: count, Backoff: backoff} }
 }
+
+// WithTokenSource supplies the bearer token per request instead of the fixed token
+// passed to New, e.g. EnvToken, FileToken or ExecToken. A 401 triggers one retry
+// with a refreshed token if the source implements TokenInvalidator.
+func WithTokenSource(ts TokenSource) Option {
+	return func(c *clientConfig) { c.tokenSource = ts }
+}
+
+// WithRateLimit caps the client at rps requests per second, allowing bursts of up
+// to burst requests (at least 1). Requests wait for a slot, honouring their
+// context; retries count against the limit too.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *clientConfig) {
+		c.rateLimit = rps
+		c.rateBurst = max(burst, 1)
+	}
+}
+
+// WithVerifyOnStart makes New call Verify before returning, so a bad token fails
+// at construction with an *AuthError rather than deep inside the first job. Each
+// probe is bounded by the client timeout.
+func WithVerifyOnStart() Option {
+	return func(c *clientConfig) { c.verifyOnStart = true }
+}
+
+// WithMaintenanceCheck makes the client consult the API's maintenance status
+// before mutating requests. During maintenance they fail with *MaintenanceError
+// (errors.Is ErrMaintenance), or wait for the window to end if cfg.Wait is set.
+// Reads are never held back.
+func WithMaintenanceCheck(cfg MaintenanceConfig) Option {
+	return func(c *clientConfig) { c.maintenance = &cfg }
+}
+
+// WithRequestCoalescing merges identical GETs that are in flight at the same time
+// into a single upstream call whose response every caller receives a copy of, so
+// many goroutines reading the same project cost one request. Calls with different
+// per-call options (WithCallTimeout, WithCallRetry, ...) are not merged. The
+// shared call runs until it completes or its last waiter gives up.
+func WithRequestCoalescing() Option {
+	return func(c *clientConfig) { c.coalesce = true }
+}
+
+// WithCache serves GETs of slow-changing endpoints from a cache (see CacheConfig
+// and DefaultCacheTTLs). A successful mutation under /v2/projects/{id} drops the
+// cached responses of that project; WithCacheBypass forces a fresh read.
+func WithCache(cfg CacheConfig) Option {
+	return func(c *clientConfig) { c.cache = &cfg }
+}
+
+// WithCompression asks the API for brotli- or gzip-compressed responses and
+// decodes them as they are read, logging both the compressed and the decoded
+// size. A body that decodes to more than 256 MiB fails with an error.
+func WithCompression() Option {
+	return func(c *clientConfig) { c.compression = true }
+}
+
+// WithConditionalRequests stores the ETag and Last-Modified of GET responses in
+// store (NewMemoryCache(1000) if nil) and revalidates later GETs of the same URL.
+// A 304 is served from the stored body, transparently to the caller.
+func WithConditionalRequests(store CacheStore) Option {
+	return func(c *clientConfig) {
+		if store == nil {
+			store = NewMemoryCache(1000)
+		}
+		c.conditional = store
+	}
+}
+
+// WithoutRawBody stops Responses from keeping the raw Body next to the decoded
+// OK, halving the memory of large listings: success bodies are decoded straight
+// off the wire. WithCallRawBody overrides it per call.
+func WithoutRawBody() Option {
+	return func(c *clientConfig) { c.noRawBody = true }
+}
+
+// WithStrictDecoding checks every decoded success response against its model and
+// calls report when the body has fields the model lacks or misses fields the
+// spec requires. Responses still decode as usual; this only observes drift
+// between the API and the spec, e.g. for alerting in staging. With a nil report,
+// drift is logged as a warning by the client's logger. Checked responses are
+// buffered even under WithoutRawBody.
+func WithStrictDecoding(report func(DriftReport)) Option {
+	return func(c *clientConfig) {
+		c.strict = true
+		c.drift = report
+	}
+}
+
+// WithRequestValidation checks JSON request bodies against their operation's
+// request schema before sending them (see ValidateRequestBody). A body that
+// violates it fails locally with a *ValidationError listing every violation,
+// instead of a 400 from the API.
+func WithRequestValidation() Option {
+	return func(c *clientConfig) { c.validate = true }
+}
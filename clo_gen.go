@@ -5749,7 +5749,7 @@ func NewAddressDeleteRequest(server string, objectId string) (*http.Request, err
 // NewAddressAttachRequest calls the generic AddressAttach builder with application/json body
 func NewAddressAttachRequest(server string, objectId string, body AddressAttachJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -5796,7 +5796,7 @@ func NewAddressAttachRequestWithBody(server string, objectId string, contentType
 // NewAddressChangeBandwidthRequest calls the generic AddressChangeBandwidth builder with application/json body
 func NewAddressChangeBandwidthRequest(server string, objectId string, body AddressChangeBandwidthJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -5945,7 +5945,7 @@ func NewAddressSetPrimaryRequest(server string, objectId string) (*http.Request,
 // NewAddressEditPtrRequest calls the generic AddressEditPtr builder with application/json body
 func NewAddressEditPtrRequest(server string, objectId string, body AddressEditPtrJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -6019,7 +6019,7 @@ func NewAccountBalanceRequest(server string) (*http.Request, error) {
 // NewDbaasBackupDeleteRequest calls the generic DbaasBackupDelete builder with application/json body
 func NewDbaasBackupDeleteRequest(server string, objectId string, body DbaasBackupDeleteJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -6202,7 +6202,7 @@ func NewDbaasClusterDetailRequest(server string, objectId string) (*http.Request
 // NewDbaasClusterUpdateRequest calls the generic DbaasClusterUpdate builder with application/json body
 func NewDbaasClusterUpdateRequest(server string, objectId string, body DbaasClusterUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -6249,7 +6249,7 @@ func NewDbaasClusterUpdateRequestWithBody(server string, objectId string, conten
 // NewDbaasClusterBackupRequest calls the generic DbaasClusterBackup builder with application/json body
 func NewDbaasClusterBackupRequest(server string, objectId string, body DbaasClusterBackupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -6432,7 +6432,7 @@ func NewClusterDbaasDatabasesListRequest(server string, objectId string) (*http.
 // NewClusterAddDatabaseRequest calls the generic ClusterAddDatabase builder with application/json body
 func NewClusterAddDatabaseRequest(server string, objectId string, body ClusterAddDatabaseJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -6513,7 +6513,7 @@ func NewClusterDbaasNodesListRequest(server string, objectId string) (*http.Requ
 // NewDbaasClusterResizeRequest calls the generic DbaasClusterResize builder with application/json body
 func NewDbaasClusterResizeRequest(server string, objectId string, body DbaasClusterResizeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -6560,7 +6560,7 @@ func NewDbaasClusterResizeRequestWithBody(server string, objectId string, conten
 // NewDbaasClusterResizeStorageRequest calls the generic DbaasClusterResizeStorage builder with application/json body
 func NewDbaasClusterResizeStorageRequest(server string, objectId string, body DbaasClusterResizeStorageJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -6743,7 +6743,7 @@ func NewDbaasDatabaseDetailRequest(server string, objectId string) (*http.Reques
 // NewClusterDatabaseBackupRequest calls the generic ClusterDatabaseBackup builder with application/json body
 func NewClusterDatabaseBackupRequest(server string, objectId string, body ClusterDatabaseBackupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -6858,7 +6858,7 @@ func NewDbaasDatabaseBackupEnableRequest(server string, objectId string) (*http.
 // NewDbaasRestoreAdminPasswordRequest calls the generic DbaasRestoreAdminPassword builder with application/json body
 func NewDbaasRestoreAdminPasswordRequest(server string, objectId string, body DbaasRestoreAdminPasswordJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -7068,7 +7068,7 @@ func NewLicenseDetailsRequest(server string, objectId string) (*http.Request, er
 // NewLicenseUpdateRequest calls the generic LicenseUpdate builder with application/json body
 func NewLicenseUpdateRequest(server string, objectId string, body LicenseUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -7142,7 +7142,7 @@ func NewAccountLimitsRequest(server string) (*http.Request, error) {
 // NewAccountPatchLimitsRequest calls the generic AccountPatchLimits builder with application/json body
 func NewAccountPatchLimitsRequest(server string, body AccountPatchLimitsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -7311,7 +7311,7 @@ func NewLoadBalancerDeleteRequest(server string, objectId string) (*http.Request
 // NewLoadBalancerRenameRequest calls the generic LoadBalancerRename builder with application/json body
 func NewLoadBalancerRenameRequest(server string, objectId string, body LoadBalancerRenameJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -7358,7 +7358,7 @@ func NewLoadBalancerRenameRequestWithBody(server string, objectId string, conten
 // NewLoadBalancerUpdateRequest calls the generic LoadBalancerUpdate builder with application/json body
 func NewLoadBalancerUpdateRequest(server string, objectId string, body LoadBalancerUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -7439,7 +7439,7 @@ func NewLoadBalancerDetailRequest(server string, objectId string) (*http.Request
 // NewLoadBalancerUpdateHealthmonitorRequest calls the generic LoadBalancerUpdateHealthmonitor builder with application/json body
 func NewLoadBalancerUpdateHealthmonitorRequest(server string, objectId string, body LoadBalancerUpdateHealthmonitorJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -7520,7 +7520,7 @@ func NewRuleListRequest(server string, objectId string) (*http.Request, error) {
 // NewRuleCreateRequest calls the generic RuleCreate builder with application/json body
 func NewRuleCreateRequest(server string, objectId string, body RuleCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -7757,7 +7757,7 @@ func NewProjectListRequest(server string) (*http.Request, error) {
 // NewProjectCreateRequest calls the generic ProjectCreate builder with application/json body
 func NewProjectCreateRequest(server string, body ProjectCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -7831,7 +7831,7 @@ func NewProjectDeleteRequest(server string, objectId string) (*http.Request, err
 // NewProjectPatchDisplayNameDescriptionRequest calls the generic ProjectPatchDisplayNameDescription builder with application/json body
 func NewProjectPatchDisplayNameDescriptionRequest(server string, objectId string, body ProjectPatchDisplayNameDescriptionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -7912,7 +7912,7 @@ func NewProjectAddressesListRequest(server string, objectId string) (*http.Reque
 // NewAddressCreateRequest calls the generic AddressCreate builder with application/json body
 func NewAddressCreateRequest(server string, objectId string, body AddressCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -8061,7 +8061,7 @@ func NewDbaasClustersListRequest(server string, objectId string) (*http.Request,
 // NewDbaasClusterCreateRequest calls the generic DbaasClusterCreate builder with application/json body
 func NewDbaasClusterCreateRequest(server string, objectId string, body DbaasClusterCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -8312,7 +8312,7 @@ func NewKeyPairsListRequest(server string, objectId string) (*http.Request, erro
 // NewImportKeypairRequest calls the generic ImportKeypair builder with application/json body
 func NewImportKeypairRequest(server string, objectId string, body ImportKeypairJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -8359,7 +8359,7 @@ func NewImportKeypairRequestWithBody(server string, objectId string, contentType
 // NewGenerateKeypairRequest calls the generic GenerateKeypair builder with application/json body
 func NewGenerateKeypairRequest(server string, objectId string, body GenerateKeypairJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -8440,7 +8440,7 @@ func NewProjectLimitsListRequest(server string, objectId string) (*http.Request,
 // NewProjectPatchLimitsRequest calls the generic ProjectPatchLimits builder with application/json body
 func NewProjectPatchLimitsRequest(server string, objectId string, body ProjectPatchLimitsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -8521,7 +8521,7 @@ func NewLoadBalancerListRequest(server string, objectId string) (*http.Request,
 // NewLoadBalancerCreateRequest calls the generic LoadBalancerCreate builder with application/json body
 func NewLoadBalancerCreateRequest(server string, objectId string, body LoadBalancerCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -8772,7 +8772,7 @@ func NewS3UsersListRequest(server string, objectId string) (*http.Request, error
 // NewS3UserCreateRequest calls the generic S3UserCreate builder with application/json body
 func NewS3UserCreateRequest(server string, objectId string, body S3UserCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -8853,7 +8853,7 @@ func NewProjectServerListRequest(server string, objectId string) (*http.Request,
 // NewServerCreateRequest calls the generic ServerCreate builder with application/json body
 func NewServerCreateRequest(server string, objectId string, body ServerCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9070,7 +9070,7 @@ func NewProjectVolumesListRequest(server string, objectId string) (*http.Request
 // NewVolumeCreateRequest calls the generic VolumeCreate builder with application/json body
 func NewVolumeCreateRequest(server string, objectId string, body VolumeCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9151,7 +9151,7 @@ func NewProjectVrouterListRequest(server string, objectId string) (*http.Request
 // NewVrouterCreateRequest calls the generic VrouterCreate builder with application/json body
 func NewVrouterCreateRequest(server string, objectId string, body VrouterCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9232,7 +9232,7 @@ func NewS3UserDeleteRequest(server string, objectId string) (*http.Request, erro
 // NewS3UserUpdateRequest calls the generic S3UserUpdate builder with application/json body
 func NewS3UserUpdateRequest(server string, objectId string, body S3UserUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9381,7 +9381,7 @@ func NewS3UserDetailsRequest(server string, objectId string) (*http.Request, err
 // NewS3UserUpdateQuotaRequest calls the generic S3UserUpdateQuota builder with application/json body
 func NewS3UserUpdateQuotaRequest(server string, objectId string, body S3UserUpdateQuotaJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9496,7 +9496,7 @@ func NewS3UserUnsuspendRequest(server string, objectId string) (*http.Request, e
 // NewServerDeleteRequest calls the generic ServerDelete builder with application/json body
 func NewServerDeleteRequest(server string, objectId string, body ServerDeleteJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9543,7 +9543,7 @@ func NewServerDeleteRequestWithBody(server string, objectId string, contentType
 // NewServerUpdateRequest calls the generic ServerUpdate builder with application/json body
 func NewServerUpdateRequest(server string, objectId string, body ServerUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9692,7 +9692,7 @@ func NewServerLicensesRequest(server string, objectId string) (*http.Request, er
 // NewServerAddLicenseRequest calls the generic ServerAddLicense builder with application/json body
 func NewServerAddLicenseRequest(server string, objectId string, body ServerAddLicenseJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9739,7 +9739,7 @@ func NewServerAddLicenseRequestWithBody(server string, objectId string, contentT
 // NewServerChangePasswordRequest calls the generic ServerChangePassword builder with application/json body
 func NewServerChangePasswordRequest(server string, objectId string, body ServerChangePasswordJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9854,7 +9854,7 @@ func NewServerRescueRequest(server string, objectId string) (*http.Request, erro
 // NewServerResizeRequest calls the generic ServerResize builder with application/json body
 func NewServerResizeRequest(server string, objectId string, body ServerResizeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -9901,7 +9901,7 @@ func NewServerResizeRequestWithBody(server string, objectId string, contentType
 // NewCreateServerSnapshotRequest calls the generic CreateServerSnapshot builder with application/json body
 func NewCreateServerSnapshotRequest(server string, objectId string, body CreateServerSnapshotJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -10084,7 +10084,7 @@ func NewSnapshotDetailsRequest(server string, objectId string) (*http.Request, e
 // NewSnapshotRestoreRequest calls the generic SnapshotRestore builder with application/json body
 func NewSnapshotRestoreRequest(server string, objectId string, body SnapshotRestoreJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -10158,7 +10158,7 @@ func NewAccountStatRequest(server string) (*http.Request, error) {
 // NewVolumeDeleteRequest calls the generic VolumeDelete builder with application/json body
 func NewVolumeDeleteRequest(server string, objectId string, body VolumeDeleteJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -10205,7 +10205,7 @@ func NewVolumeDeleteRequestWithBody(server string, objectId string, contentType
 // NewVolumeUpdateRequest calls the generic VolumeUpdate builder with application/json body
 func NewVolumeUpdateRequest(server string, objectId string, body VolumeUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -10252,7 +10252,7 @@ func NewVolumeUpdateRequestWithBody(server string, objectId string, contentType
 // NewVolumeAttachRequest calls the generic VolumeAttach builder with application/json body
 func NewVolumeAttachRequest(server string, objectId string, body VolumeAttachJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -10299,7 +10299,7 @@ func NewVolumeAttachRequestWithBody(server string, objectId string, contentType
 // NewVolumeDetachRequest calls the generic VolumeDetach builder with application/json body
 func NewVolumeDetachRequest(server string, objectId string, body VolumeDetachJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -10380,7 +10380,7 @@ func NewVolumeDetailRequest(server string, objectId string) (*http.Request, erro
 // NewVolumeExtendRequest calls the generic VolumeExtend builder with application/json body
 func NewVolumeExtendRequest(server string, objectId string, body VolumeExtendJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := jsonCodec.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -10726,7 +10726,7 @@ func (c *ClientWithResponses) AddressDeleteWithResponse(ctx context.Context, obj
 }
 
 type AddressDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -10742,26 +10742,29 @@ func (r AddressDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAddressDeleteResponse(rsp *http.Response) (*AddressDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AddressDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -10787,7 +10790,7 @@ func (c *ClientWithResponses) AddressAttachWithResponse(ctx context.Context, obj
 }
 
 type AddressAttachResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -10804,26 +10807,40 @@ func (r AddressAttachResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAddressAttachResponse(rsp *http.Response) (*AddressAttachResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AddressAttachResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -10837,9 +10854,10 @@ func ParseAddressAttachResponse(rsp *http.Response) (*AddressAttachResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -10854,7 +10872,7 @@ func (c *ClientWithResponses) AddressChangeBandwidthWithResponse(ctx context.Con
 }
 
 type AddressChangeBandwidthResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -10871,26 +10889,40 @@ func (r AddressChangeBandwidthResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAddressChangeBandwidthResponse(rsp *http.Response) (*AddressChangeBandwidthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AddressChangeBandwidthResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -10904,9 +10936,10 @@ func ParseAddressChangeBandwidthResponse(rsp *http.Response) (*AddressChangeBand
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -10921,7 +10954,7 @@ func (c *ClientWithResponses) AddressDetachWithResponse(ctx context.Context, obj
 }
 
 type AddressDetachResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -10938,26 +10971,40 @@ func (r AddressDetachResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAddressDetachResponse(rsp *http.Response) (*AddressDetachResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AddressDetachResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -10971,9 +11018,10 @@ func ParseAddressDetachResponse(rsp *http.Response) (*AddressDetachResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -10988,7 +11036,7 @@ func (c *ClientWithResponses) AddressDetailWithResponse(ctx context.Context, obj
 }
 
 type AddressDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *AddressDetailSchema
 	Error        *ApiError
@@ -11005,26 +11053,40 @@ func (r AddressDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAddressDetailResponse(rsp *http.Response) (*AddressDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AddressDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11038,9 +11100,10 @@ func ParseAddressDetailResponse(rsp *http.Response) (*AddressDetailResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11055,7 +11118,7 @@ func (c *ClientWithResponses) AddressSetPrimaryWithResponse(ctx context.Context,
 }
 
 type AddressSetPrimaryResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -11072,26 +11135,40 @@ func (r AddressSetPrimaryResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAddressSetPrimaryResponse(rsp *http.Response) (*AddressSetPrimaryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AddressSetPrimaryResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11105,9 +11182,10 @@ func ParseAddressSetPrimaryResponse(rsp *http.Response) (*AddressSetPrimaryRespo
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11122,7 +11200,7 @@ func (c *ClientWithResponses) AddressEditPtrWithResponse(ctx context.Context, ob
 }
 
 type AddressEditPtrResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -11139,26 +11217,40 @@ func (r AddressEditPtrResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAddressEditPtrResponse(rsp *http.Response) (*AddressEditPtrResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AddressEditPtrResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11172,9 +11264,10 @@ func ParseAddressEditPtrResponse(rsp *http.Response) (*AddressEditPtrResponse, e
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11189,7 +11282,7 @@ func (c *ClientWithResponses) AccountBalanceWithResponse(ctx context.Context, re
 }
 
 type AccountBalanceResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedAccountBalanceSchemaE14b0754
 	Error        *ApiError
@@ -11206,26 +11299,40 @@ func (r AccountBalanceResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAccountBalanceResponse(rsp *http.Response) (*AccountBalanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AccountBalanceResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11239,9 +11346,10 @@ func ParseAccountBalanceResponse(rsp *http.Response) (*AccountBalanceResponse, e
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11256,7 +11364,7 @@ func (c *ClientWithResponses) DbaasBackupDeleteWithResponse(ctx context.Context,
 }
 
 type DbaasBackupDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -11272,26 +11380,29 @@ func (r DbaasBackupDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasBackupDeleteResponse(rsp *http.Response) (*DbaasBackupDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasBackupDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11317,7 +11428,7 @@ func (c *ClientWithResponses) DbaasBackupDetailWithResponse(ctx context.Context,
 }
 
 type DbaasBackupDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedDbaasBackupSchema10e1206e
 	Error        *ApiError
@@ -11334,26 +11445,40 @@ func (r DbaasBackupDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasBackupDetailResponse(rsp *http.Response) (*DbaasBackupDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasBackupDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11367,9 +11492,10 @@ func ParseDbaasBackupDetailResponse(rsp *http.Response) (*DbaasBackupDetailRespo
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11384,7 +11510,7 @@ func (c *ClientWithResponses) DbaasBackupDownloadWithResponse(ctx context.Contex
 }
 
 type DbaasBackupDownloadResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedBackupDownloadUrlSchemaB4acf4f5
 	Error        *ApiError
@@ -11401,26 +11527,40 @@ func (r DbaasBackupDownloadResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasBackupDownloadResponse(rsp *http.Response) (*DbaasBackupDownloadResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasBackupDownloadResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11434,9 +11574,10 @@ func ParseDbaasBackupDownloadResponse(rsp *http.Response) (*DbaasBackupDownloadR
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11451,7 +11592,7 @@ func (c *ClientWithResponses) DbaasClusterDeleteWithResponse(ctx context.Context
 }
 
 type DbaasClusterDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -11467,26 +11608,29 @@ func (r DbaasClusterDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterDeleteResponse(rsp *http.Response) (*DbaasClusterDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11512,7 +11656,7 @@ func (c *ClientWithResponses) DbaasClusterDetailWithResponse(ctx context.Context
 }
 
 type DbaasClusterDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedDbaasClusterSchema0b036303
 	Error        *ApiError
@@ -11529,26 +11673,40 @@ func (r DbaasClusterDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterDetailResponse(rsp *http.Response) (*DbaasClusterDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11562,9 +11720,10 @@ func ParseDbaasClusterDetailResponse(rsp *http.Response) (*DbaasClusterDetailRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11579,7 +11738,7 @@ func (c *ClientWithResponses) DbaasClusterUpdateWithResponse(ctx context.Context
 }
 
 type DbaasClusterUpdateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -11596,26 +11755,40 @@ func (r DbaasClusterUpdateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterUpdateResponse(rsp *http.Response) (*DbaasClusterUpdateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterUpdateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11629,9 +11802,10 @@ func ParseDbaasClusterUpdateResponse(rsp *http.Response) (*DbaasClusterUpdateRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11646,7 +11820,7 @@ func (c *ClientWithResponses) DbaasClusterBackupWithResponse(ctx context.Context
 }
 
 type DbaasClusterBackupResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchemaC277c867
 	Error        *ApiError
@@ -11663,26 +11837,40 @@ func (r DbaasClusterBackupResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterBackupResponse(rsp *http.Response) (*DbaasClusterBackupResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterBackupResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11696,9 +11884,10 @@ func ParseDbaasClusterBackupResponse(rsp *http.Response) (*DbaasClusterBackupRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11713,7 +11902,7 @@ func (c *ClientWithResponses) DbaasClusterBackupDisableWithResponse(ctx context.
 }
 
 type DbaasClusterBackupDisableResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -11730,26 +11919,40 @@ func (r DbaasClusterBackupDisableResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterBackupDisableResponse(rsp *http.Response) (*DbaasClusterBackupDisableResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterBackupDisableResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11763,9 +11966,10 @@ func ParseDbaasClusterBackupDisableResponse(rsp *http.Response) (*DbaasClusterBa
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11780,7 +11984,7 @@ func (c *ClientWithResponses) DbaasClusterBackupEnableWithResponse(ctx context.C
 }
 
 type DbaasClusterBackupEnableResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -11797,42 +12001,57 @@ func (r DbaasClusterBackupEnableResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterBackupEnableResponse(rsp *http.Response) (*DbaasClusterBackupEnableResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterBackupEnableResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
 
-	if status >= 400 {
-		// Seed Code with the HTTP status; a "code" field in the body overrides it.
-		// This mirrors the v2 DefaultError semantics that consumers classify on.
-		apiErr := &ApiError{Code: status}
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
-				apiErr = &ApiError{
-					Message:     "failed to unmarshal error response",
-					Description: string(bodyBytes),
-					Code:        status,
-				}
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 			}
-		} else {
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
+
+	if status >= 400 {
+		// Seed Code with the HTTP status; a "code" field in the body overrides it.
+		// This mirrors the v2 DefaultError semantics that consumers classify on.
+		apiErr := &ApiError{Code: status}
+		if strings.Contains(contentType, "json") {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
+				apiErr = &ApiError{
+					Message:     "failed to unmarshal error response",
+					Description: string(bodyBytes),
+					Code:        status,
+				}
+			}
+		} else {
 			apiErr.Message = string(bodyBytes)
 		}
 		response.Error = apiErr
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11847,7 +12066,7 @@ func (c *ClientWithResponses) DbaasClusterConfigWithResponse(ctx context.Context
 }
 
 type DbaasClusterConfigResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedDbaasClusterConfigSchemaC07bbcb4
 	Error        *ApiError
@@ -11864,26 +12083,40 @@ func (r DbaasClusterConfigResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterConfigResponse(rsp *http.Response) (*DbaasClusterConfigResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterConfigResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11897,9 +12130,10 @@ func ParseDbaasClusterConfigResponse(rsp *http.Response) (*DbaasClusterConfigRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11914,7 +12148,7 @@ func (c *ClientWithResponses) ClusterDbaasDatabasesListWithResponse(ctx context.
 }
 
 type ClusterDbaasDatabasesListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListDbaasDatababaseSchemaF477384d
 	Error        *ApiError
@@ -11931,26 +12165,40 @@ func (r ClusterDbaasDatabasesListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseClusterDbaasDatabasesListResponse(rsp *http.Response) (*ClusterDbaasDatabasesListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ClusterDbaasDatabasesListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -11964,9 +12212,10 @@ func ParseClusterDbaasDatabasesListResponse(rsp *http.Response) (*ClusterDbaasDa
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -11981,7 +12230,7 @@ func (c *ClientWithResponses) ClusterAddDatabaseWithResponse(ctx context.Context
 }
 
 type ClusterAddDatabaseResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchema479878c0
 	Error        *ApiError
@@ -11998,26 +12247,40 @@ func (r ClusterAddDatabaseResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseClusterAddDatabaseResponse(rsp *http.Response) (*ClusterAddDatabaseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ClusterAddDatabaseResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12031,9 +12294,10 @@ func ParseClusterAddDatabaseResponse(rsp *http.Response) (*ClusterAddDatabaseRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12048,7 +12312,7 @@ func (c *ClientWithResponses) ClusterDbaasNodesListWithResponse(ctx context.Cont
 }
 
 type ClusterDbaasNodesListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListDbaasNodeSchema9b10c181
 	Error        *ApiError
@@ -12065,26 +12329,40 @@ func (r ClusterDbaasNodesListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseClusterDbaasNodesListResponse(rsp *http.Response) (*ClusterDbaasNodesListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ClusterDbaasNodesListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12098,9 +12376,10 @@ func ParseClusterDbaasNodesListResponse(rsp *http.Response) (*ClusterDbaasNodesL
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12115,7 +12394,7 @@ func (c *ClientWithResponses) DbaasClusterResizeWithResponse(ctx context.Context
 }
 
 type DbaasClusterResizeResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -12132,26 +12411,40 @@ func (r DbaasClusterResizeResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterResizeResponse(rsp *http.Response) (*DbaasClusterResizeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterResizeResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12165,9 +12458,10 @@ func ParseDbaasClusterResizeResponse(rsp *http.Response) (*DbaasClusterResizeRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12182,7 +12476,7 @@ func (c *ClientWithResponses) DbaasClusterResizeStorageWithResponse(ctx context.
 }
 
 type DbaasClusterResizeStorageResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -12199,26 +12493,40 @@ func (r DbaasClusterResizeStorageResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterResizeStorageResponse(rsp *http.Response) (*DbaasClusterResizeStorageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterResizeStorageResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12232,9 +12540,10 @@ func ParseDbaasClusterResizeStorageResponse(rsp *http.Response) (*DbaasClusterRe
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12249,7 +12558,7 @@ func (c *ClientWithResponses) DbaasClusterStartWithResponse(ctx context.Context,
 }
 
 type DbaasClusterStartResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -12266,26 +12575,40 @@ func (r DbaasClusterStartResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterStartResponse(rsp *http.Response) (*DbaasClusterStartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterStartResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12299,9 +12622,10 @@ func ParseDbaasClusterStartResponse(rsp *http.Response) (*DbaasClusterStartRespo
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12316,7 +12640,7 @@ func (c *ClientWithResponses) DbaasClusterStopWithResponse(ctx context.Context,
 }
 
 type DbaasClusterStopResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -12333,26 +12657,40 @@ func (r DbaasClusterStopResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterStopResponse(rsp *http.Response) (*DbaasClusterStopResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClusterStopResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12366,9 +12704,10 @@ func ParseDbaasClusterStopResponse(rsp *http.Response) (*DbaasClusterStopRespons
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12383,7 +12722,7 @@ func (c *ClientWithResponses) DbaasDatabaseDeleteWithResponse(ctx context.Contex
 }
 
 type DbaasDatabaseDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -12399,26 +12738,29 @@ func (r DbaasDatabaseDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasDatabaseDeleteResponse(rsp *http.Response) (*DbaasDatabaseDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasDatabaseDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12444,7 +12786,7 @@ func (c *ClientWithResponses) DbaasDatabaseDetailWithResponse(ctx context.Contex
 }
 
 type DbaasDatabaseDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedDbaasDatababaseSchemaD9185bf9
 	Error        *ApiError
@@ -12461,26 +12803,40 @@ func (r DbaasDatabaseDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasDatabaseDetailResponse(rsp *http.Response) (*DbaasDatabaseDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasDatabaseDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12494,9 +12850,10 @@ func ParseDbaasDatabaseDetailResponse(rsp *http.Response) (*DbaasDatabaseDetailR
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12511,7 +12868,7 @@ func (c *ClientWithResponses) ClusterDatabaseBackupWithResponse(ctx context.Cont
 }
 
 type ClusterDatabaseBackupResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchema07ea1a4d
 	Error        *ApiError
@@ -12528,26 +12885,40 @@ func (r ClusterDatabaseBackupResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseClusterDatabaseBackupResponse(rsp *http.Response) (*ClusterDatabaseBackupResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ClusterDatabaseBackupResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12561,9 +12932,10 @@ func ParseClusterDatabaseBackupResponse(rsp *http.Response) (*ClusterDatabaseBac
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12578,7 +12950,7 @@ func (c *ClientWithResponses) DbaasDatabaseBackupDisableWithResponse(ctx context
 }
 
 type DbaasDatabaseBackupDisableResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -12595,26 +12967,40 @@ func (r DbaasDatabaseBackupDisableResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasDatabaseBackupDisableResponse(rsp *http.Response) (*DbaasDatabaseBackupDisableResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasDatabaseBackupDisableResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12628,9 +13014,10 @@ func ParseDbaasDatabaseBackupDisableResponse(rsp *http.Response) (*DbaasDatabase
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12645,7 +13032,7 @@ func (c *ClientWithResponses) DbaasDatabaseBackupEnableWithResponse(ctx context.
 }
 
 type DbaasDatabaseBackupEnableResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -12662,26 +13049,40 @@ func (r DbaasDatabaseBackupEnableResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasDatabaseBackupEnableResponse(rsp *http.Response) (*DbaasDatabaseBackupEnableResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasDatabaseBackupEnableResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12695,9 +13096,10 @@ func ParseDbaasDatabaseBackupEnableResponse(rsp *http.Response) (*DbaasDatabaseB
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12712,7 +13114,7 @@ func (c *ClientWithResponses) DbaasRestoreAdminPasswordWithResponse(ctx context.
 }
 
 type DbaasRestoreAdminPasswordResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -12729,26 +13131,40 @@ func (r DbaasRestoreAdminPasswordResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasRestoreAdminPasswordResponse(rsp *http.Response) (*DbaasRestoreAdminPasswordResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasRestoreAdminPasswordResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12762,9 +13178,10 @@ func ParseDbaasRestoreAdminPasswordResponse(rsp *http.Response) (*DbaasRestoreAd
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12779,7 +13196,7 @@ func (c *ClientWithResponses) KeypairDeleteWithResponse(ctx context.Context, obj
 }
 
 type KeypairDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -12795,26 +13212,29 @@ func (r KeypairDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseKeypairDeleteResponse(rsp *http.Response) (*KeypairDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &KeypairDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12840,7 +13260,7 @@ func (c *ClientWithResponses) KeypairDetailWithResponse(ctx context.Context, obj
 }
 
 type KeypairDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedKeyPairSchema608d05d6
 	Error        *ApiError
@@ -12857,26 +13277,40 @@ func (r KeypairDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseKeypairDetailResponse(rsp *http.Response) (*KeypairDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &KeypairDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12890,9 +13324,10 @@ func ParseKeypairDetailResponse(rsp *http.Response) (*KeypairDetailResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12907,7 +13342,7 @@ func (c *ClientWithResponses) AvailableLicensesListWithResponse(ctx context.Cont
 }
 
 type AvailableLicensesListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListLicenseOfferSchemaC7ca8d2b
 	Error        *ApiError
@@ -12924,26 +13359,40 @@ func (r AvailableLicensesListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAvailableLicensesListResponse(rsp *http.Response) (*AvailableLicensesListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AvailableLicensesListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -12957,9 +13406,10 @@ func ParseAvailableLicensesListResponse(rsp *http.Response) (*AvailableLicensesL
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -12974,7 +13424,7 @@ func (c *ClientWithResponses) LicenseDeleteWithResponse(ctx context.Context, obj
 }
 
 type LicenseDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -12990,26 +13440,29 @@ func (r LicenseDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLicenseDeleteResponse(rsp *http.Response) (*LicenseDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LicenseDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13035,7 +13488,7 @@ func (c *ClientWithResponses) LicenseDetailsWithResponse(ctx context.Context, ob
 }
 
 type LicenseDetailsResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedLicenseSchemaBbb1dc03
 	Error        *ApiError
@@ -13052,26 +13505,40 @@ func (r LicenseDetailsResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLicenseDetailsResponse(rsp *http.Response) (*LicenseDetailsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LicenseDetailsResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13085,9 +13552,10 @@ func ParseLicenseDetailsResponse(rsp *http.Response) (*LicenseDetailsResponse, e
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13102,7 +13570,7 @@ func (c *ClientWithResponses) LicenseUpdateWithResponse(ctx context.Context, obj
 }
 
 type LicenseUpdateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -13119,26 +13587,40 @@ func (r LicenseUpdateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLicenseUpdateResponse(rsp *http.Response) (*LicenseUpdateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LicenseUpdateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13152,9 +13634,10 @@ func ParseLicenseUpdateResponse(rsp *http.Response) (*LicenseUpdateResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13169,7 +13652,7 @@ func (c *ClientWithResponses) AccountLimitsWithResponse(ctx context.Context, req
 }
 
 type AccountLimitsResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *AccountLimitSchema
 	Error        *ApiError
@@ -13186,26 +13669,40 @@ func (r AccountLimitsResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAccountLimitsResponse(rsp *http.Response) (*AccountLimitsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AccountLimitsResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13219,9 +13716,10 @@ func ParseAccountLimitsResponse(rsp *http.Response) (*AccountLimitsResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13236,7 +13734,7 @@ func (c *ClientWithResponses) AccountPatchLimitsWithResponse(ctx context.Context
 }
 
 type AccountPatchLimitsResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -13253,26 +13751,40 @@ func (r AccountPatchLimitsResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAccountPatchLimitsResponse(rsp *http.Response) (*AccountPatchLimitsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AccountPatchLimitsResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13286,9 +13798,10 @@ func ParseAccountPatchLimitsResponse(rsp *http.Response) (*AccountPatchLimitsRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13303,7 +13816,7 @@ func (c *ClientWithResponses) AccountProjectsLimitsWithResponse(ctx context.Cont
 }
 
 type AccountProjectsLimitsResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *AccountProjectLimitSchema
 	Error        *ApiError
@@ -13320,26 +13833,40 @@ func (r AccountProjectsLimitsResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAccountProjectsLimitsResponse(rsp *http.Response) (*AccountProjectsLimitsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AccountProjectsLimitsResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13353,9 +13880,10 @@ func ParseAccountProjectsLimitsResponse(rsp *http.Response) (*AccountProjectsLim
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13370,7 +13898,7 @@ func (c *ClientWithResponses) RuleDeleteWithResponse(ctx context.Context, object
 }
 
 type RuleDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -13386,26 +13914,29 @@ func (r RuleDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseRuleDeleteResponse(rsp *http.Response) (*RuleDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &RuleDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13431,7 +13962,7 @@ func (c *ClientWithResponses) RuleDetailWithResponse(ctx context.Context, object
 }
 
 type RuleDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedRuleDetailResponseSchemaBab89e66
 	Error        *ApiError
@@ -13448,26 +13979,40 @@ func (r RuleDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseRuleDetailResponse(rsp *http.Response) (*RuleDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &RuleDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13481,9 +14026,10 @@ func ParseRuleDetailResponse(rsp *http.Response) (*RuleDetailResponse, error) {
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13498,7 +14044,7 @@ func (c *ClientWithResponses) LoadBalancerDeleteWithResponse(ctx context.Context
 }
 
 type LoadBalancerDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -13514,26 +14060,29 @@ func (r LoadBalancerDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerDeleteResponse(rsp *http.Response) (*LoadBalancerDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13559,7 +14108,7 @@ func (c *ClientWithResponses) LoadBalancerRenameWithResponse(ctx context.Context
 }
 
 type LoadBalancerRenameResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -13576,26 +14125,40 @@ func (r LoadBalancerRenameResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerRenameResponse(rsp *http.Response) (*LoadBalancerRenameResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerRenameResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13609,9 +14172,10 @@ func ParseLoadBalancerRenameResponse(rsp *http.Response) (*LoadBalancerRenameRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13626,7 +14190,7 @@ func (c *ClientWithResponses) LoadBalancerUpdateWithResponse(ctx context.Context
 }
 
 type LoadBalancerUpdateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -13643,26 +14207,40 @@ func (r LoadBalancerUpdateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerUpdateResponse(rsp *http.Response) (*LoadBalancerUpdateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerUpdateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13676,9 +14254,10 @@ func ParseLoadBalancerUpdateResponse(rsp *http.Response) (*LoadBalancerUpdateRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13693,7 +14272,7 @@ func (c *ClientWithResponses) LoadBalancerDetailWithResponse(ctx context.Context
 }
 
 type LoadBalancerDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedLBDetailResponseSchemaB0fafd3b
 	Error        *ApiError
@@ -13710,26 +14289,40 @@ func (r LoadBalancerDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerDetailResponse(rsp *http.Response) (*LoadBalancerDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13743,9 +14336,10 @@ func ParseLoadBalancerDetailResponse(rsp *http.Response) (*LoadBalancerDetailRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13760,7 +14354,7 @@ func (c *ClientWithResponses) LoadBalancerUpdateHealthmonitorWithResponse(ctx co
 }
 
 type LoadBalancerUpdateHealthmonitorResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -13777,26 +14371,40 @@ func (r LoadBalancerUpdateHealthmonitorResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerUpdateHealthmonitorResponse(rsp *http.Response) (*LoadBalancerUpdateHealthmonitorResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerUpdateHealthmonitorResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13810,9 +14418,10 @@ func ParseLoadBalancerUpdateHealthmonitorResponse(rsp *http.Response) (*LoadBala
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13827,7 +14436,7 @@ func (c *ClientWithResponses) RuleListWithResponse(ctx context.Context, objectId
 }
 
 type RuleListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListRuleDetailResponseSchema3d329e13
 	Error        *ApiError
@@ -13844,26 +14453,40 @@ func (r RuleListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseRuleListResponse(rsp *http.Response) (*RuleListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &RuleListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
-
-	status := rsp.StatusCode
-	contentType := rsp.Header.Get("Content-Type")
+
+	status := rsp.StatusCode
+	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13877,9 +14500,10 @@ func ParseRuleListResponse(rsp *http.Response) (*RuleListResponse, error) {
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13894,7 +14518,7 @@ func (c *ClientWithResponses) RuleCreateWithResponse(ctx context.Context, object
 }
 
 type RuleCreateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchema506fd633
 	Error        *ApiError
@@ -13911,26 +14535,40 @@ func (r RuleCreateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseRuleCreateResponse(rsp *http.Response) (*RuleCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &RuleCreateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -13944,9 +14582,10 @@ func ParseRuleCreateResponse(rsp *http.Response) (*RuleCreateResponse, error) {
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -13961,7 +14600,7 @@ func (c *ClientWithResponses) LoadBalancerEnableWithResponse(ctx context.Context
 }
 
 type LoadBalancerEnableResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -13978,26 +14617,40 @@ func (r LoadBalancerEnableResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerEnableResponse(rsp *http.Response) (*LoadBalancerEnableResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerEnableResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14011,9 +14664,10 @@ func ParseLoadBalancerEnableResponse(rsp *http.Response) (*LoadBalancerEnableRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14028,7 +14682,7 @@ func (c *ClientWithResponses) LoadBalancerStatWithResponse(ctx context.Context,
 }
 
 type LoadBalancerStatResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedLbStatSchemaBa0fc678
 	Error        *ApiError
@@ -14045,26 +14699,40 @@ func (r LoadBalancerStatResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerStatResponse(rsp *http.Response) (*LoadBalancerStatResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerStatResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14078,9 +14746,10 @@ func ParseLoadBalancerStatResponse(rsp *http.Response) (*LoadBalancerStatRespons
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14095,7 +14764,7 @@ func (c *ClientWithResponses) LoadBalancerStopWithResponse(ctx context.Context,
 }
 
 type LoadBalancerStopResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -14112,26 +14781,40 @@ func (r LoadBalancerStopResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerStopResponse(rsp *http.Response) (*LoadBalancerStopResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerStopResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14145,9 +14828,10 @@ func ParseLoadBalancerStopResponse(rsp *http.Response) (*LoadBalancerStopRespons
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14162,7 +14846,7 @@ func (c *ClientWithResponses) LocalDiskDetailWithResponse(ctx context.Context, o
 }
 
 type LocalDiskDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *LocalDiskDetail
 	Error        *ApiError
@@ -14179,26 +14863,40 @@ func (r LocalDiskDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLocalDiskDetailResponse(rsp *http.Response) (*LocalDiskDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LocalDiskDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14212,9 +14910,10 @@ func ParseLocalDiskDetailResponse(rsp *http.Response) (*LocalDiskDetailResponse,
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14229,7 +14928,7 @@ func (c *ClientWithResponses) MaintenanceModeStatusWithResponse(ctx context.Cont
 }
 
 type MaintenanceModeStatusResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *MaintenanceModeSchema
 	Error        *ApiError
@@ -14246,26 +14945,40 @@ func (r MaintenanceModeStatusResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseMaintenanceModeStatusResponse(rsp *http.Response) (*MaintenanceModeStatusResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &MaintenanceModeStatusResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14279,9 +14992,10 @@ func ParseMaintenanceModeStatusResponse(rsp *http.Response) (*MaintenanceModeSta
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14296,7 +15010,7 @@ func (c *ClientWithResponses) ProjectListWithResponse(ctx context.Context, reqEd
 }
 
 type ProjectListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ProjectPagListSchema
 	Error        *ApiError
@@ -14313,26 +15027,40 @@ func (r ProjectListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectListResponse(rsp *http.Response) (*ProjectListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14346,9 +15074,10 @@ func ParseProjectListResponse(rsp *http.Response) (*ProjectListResponse, error)
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14363,7 +15092,7 @@ func (c *ClientWithResponses) ProjectCreateWithResponse(ctx context.Context, bod
 }
 
 type ProjectCreateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchemaF7a555c6
 	Error        *ApiError
@@ -14380,26 +15109,40 @@ func (r ProjectCreateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectCreateResponse(rsp *http.Response) (*ProjectCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectCreateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14413,9 +15156,10 @@ func ParseProjectCreateResponse(rsp *http.Response) (*ProjectCreateResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14430,7 +15174,7 @@ func (c *ClientWithResponses) ProjectDeleteWithResponse(ctx context.Context, obj
 }
 
 type ProjectDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -14446,26 +15190,29 @@ func (r ProjectDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectDeleteResponse(rsp *http.Response) (*ProjectDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14491,7 +15238,7 @@ func (c *ClientWithResponses) ProjectPatchDisplayNameDescriptionWithResponse(ctx
 }
 
 type ProjectPatchDisplayNameDescriptionResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -14508,26 +15255,40 @@ func (r ProjectPatchDisplayNameDescriptionResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectPatchDisplayNameDescriptionResponse(rsp *http.Response) (*ProjectPatchDisplayNameDescriptionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectPatchDisplayNameDescriptionResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14541,9 +15302,10 @@ func ParseProjectPatchDisplayNameDescriptionResponse(rsp *http.Response) (*Proje
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14558,7 +15320,7 @@ func (c *ClientWithResponses) ProjectAddressesListWithResponse(ctx context.Conte
 }
 
 type ProjectAddressesListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagAddressSchema
 	Error        *ApiError
@@ -14575,26 +15337,40 @@ func (r ProjectAddressesListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectAddressesListResponse(rsp *http.Response) (*ProjectAddressesListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectAddressesListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14608,9 +15384,10 @@ func ParseProjectAddressesListResponse(rsp *http.Response) (*ProjectAddressesLis
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14625,7 +15402,7 @@ func (c *ClientWithResponses) AddressCreateWithResponse(ctx context.Context, obj
 }
 
 type AddressCreateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *AddressCreateSchema
 	Error        *ApiError
@@ -14642,26 +15419,40 @@ func (r AddressCreateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAddressCreateResponse(rsp *http.Response) (*AddressCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AddressCreateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14675,9 +15466,10 @@ func ParseAddressCreateResponse(rsp *http.Response) (*AddressCreateResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14692,7 +15484,7 @@ func (c *ClientWithResponses) ProjectConsumptionWithResponse(ctx context.Context
 }
 
 type ProjectConsumptionResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ConsumptionListSchema
 	Error        *ApiError
@@ -14709,26 +15501,40 @@ func (r ProjectConsumptionResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectConsumptionResponse(rsp *http.Response) (*ProjectConsumptionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectConsumptionResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14742,9 +15548,10 @@ func ParseProjectConsumptionResponse(rsp *http.Response) (*ProjectConsumptionRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14759,7 +15566,7 @@ func (c *ClientWithResponses) ProjectBackupListWithResponse(ctx context.Context,
 }
 
 type ProjectBackupListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListDbaasBackupSchema499765e5
 	Error        *ApiError
@@ -14776,26 +15583,40 @@ func (r ProjectBackupListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectBackupListResponse(rsp *http.Response) (*ProjectBackupListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectBackupListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14809,9 +15630,10 @@ func ParseProjectBackupListResponse(rsp *http.Response) (*ProjectBackupListRespo
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14826,7 +15648,7 @@ func (c *ClientWithResponses) DbaasClustersListWithResponse(ctx context.Context,
 }
 
 type DbaasClustersListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListDbaasClusterSchema583f8e35
 	Error        *ApiError
@@ -14843,26 +15665,40 @@ func (r DbaasClustersListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClustersListResponse(rsp *http.Response) (*DbaasClustersListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &DbaasClustersListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14876,9 +15712,10 @@ func ParseDbaasClustersListResponse(rsp *http.Response) (*DbaasClustersListRespo
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14893,7 +15730,7 @@ func (c *ClientWithResponses) DbaasClusterCreateWithResponse(ctx context.Context
 }
 
 type DbaasClusterCreateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchema91049310
 	Error        *ApiError
@@ -14910,26 +15747,40 @@ func (r DbaasClusterCreateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseDbaasClusterCreateResponse(rsp *http.Response) (*DbaasClusterCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
+	response := &DbaasClusterCreateResponse{
+		HTTPResponse: rsp,
+	}
+
+	status := rsp.StatusCode
+	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
 	if err != nil {
 		return nil, err
 	}
-
-	response := &DbaasClusterCreateResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	if retain {
+		response.Body = bodyBytes
 	}
 
-	status := rsp.StatusCode
-	contentType := rsp.Header.Get("Content-Type")
-
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -14943,9 +15794,10 @@ func ParseDbaasClusterCreateResponse(rsp *http.Response) (*DbaasClusterCreateRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -14960,7 +15812,7 @@ func (c *ClientWithResponses) ProjectDbaasDatabasesListWithResponse(ctx context.
 }
 
 type ProjectDbaasDatabasesListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListDbaasDatababaseSchemaB43fad10
 	Error        *ApiError
@@ -14977,26 +15829,40 @@ func (r ProjectDbaasDatabasesListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectDbaasDatabasesListResponse(rsp *http.Response) (*ProjectDbaasDatabasesListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectDbaasDatabasesListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15010,9 +15876,10 @@ func ParseProjectDbaasDatabasesListResponse(rsp *http.Response) (*ProjectDbaasDa
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15027,7 +15894,7 @@ func (c *ClientWithResponses) ProjectDbaasDatastoresWithResponse(ctx context.Con
 }
 
 type ProjectDbaasDatastoresResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListDatastoreSchemaCff1b68f
 	Error        *ApiError
@@ -15044,26 +15911,40 @@ func (r ProjectDbaasDatastoresResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectDbaasDatastoresResponse(rsp *http.Response) (*ProjectDbaasDatastoresResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectDbaasDatastoresResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15077,9 +15958,10 @@ func ParseProjectDbaasDatastoresResponse(rsp *http.Response) (*ProjectDbaasDatas
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15094,7 +15976,7 @@ func (c *ClientWithResponses) ProjectDbaasConfigDepWithResponse(ctx context.Cont
 }
 
 type ProjectDbaasConfigDepResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagConfigDepSchema
 	Error        *ApiError
@@ -15111,26 +15993,40 @@ func (r ProjectDbaasConfigDepResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectDbaasConfigDepResponse(rsp *http.Response) (*ProjectDbaasConfigDepResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectDbaasConfigDepResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15144,9 +16040,10 @@ func ParseProjectDbaasConfigDepResponse(rsp *http.Response) (*ProjectDbaasConfig
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15161,7 +16058,7 @@ func (c *ClientWithResponses) ProjectDetailWithResponse(ctx context.Context, obj
 }
 
 type ProjectDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedProjectDetailSchemaFa5e874e
 	Error        *ApiError
@@ -15178,26 +16075,40 @@ func (r ProjectDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectDetailResponse(rsp *http.Response) (*ProjectDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15211,9 +16122,10 @@ func ParseProjectDetailResponse(rsp *http.Response) (*ProjectDetailResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15228,7 +16140,7 @@ func (c *ClientWithResponses) ProjectImagesListWithResponse(ctx context.Context,
 }
 
 type ProjectImagesListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagImageSchema
 	Error        *ApiError
@@ -15245,26 +16157,40 @@ func (r ProjectImagesListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectImagesListResponse(rsp *http.Response) (*ProjectImagesListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectImagesListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15278,9 +16204,10 @@ func ParseProjectImagesListResponse(rsp *http.Response) (*ProjectImagesListRespo
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15295,7 +16222,7 @@ func (c *ClientWithResponses) KeyPairsListWithResponse(ctx context.Context, obje
 }
 
 type KeyPairsListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagKeyPairSchema
 	Error        *ApiError
@@ -15312,26 +16239,40 @@ func (r KeyPairsListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseKeyPairsListResponse(rsp *http.Response) (*KeyPairsListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &KeyPairsListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15345,9 +16286,10 @@ func ParseKeyPairsListResponse(rsp *http.Response) (*KeyPairsListResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15362,7 +16304,7 @@ func (c *ClientWithResponses) ImportKeypairWithResponse(ctx context.Context, obj
 }
 
 type ImportKeypairResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedKeyPairSchema608d05d6
 	Error        *ApiError
@@ -15379,26 +16321,40 @@ func (r ImportKeypairResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseImportKeypairResponse(rsp *http.Response) (*ImportKeypairResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ImportKeypairResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15412,9 +16368,10 @@ func ParseImportKeypairResponse(rsp *http.Response) (*ImportKeypairResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15429,7 +16386,7 @@ func (c *ClientWithResponses) GenerateKeypairWithResponse(ctx context.Context, o
 }
 
 type GenerateKeypairResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedGenerateKeyPairResultSchema082b209e
 	Error        *ApiError
@@ -15446,26 +16403,40 @@ func (r GenerateKeypairResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseGenerateKeypairResponse(rsp *http.Response) (*GenerateKeypairResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &GenerateKeypairResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15479,9 +16450,10 @@ func ParseGenerateKeypairResponse(rsp *http.Response) (*GenerateKeypairResponse,
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15496,7 +16468,7 @@ func (c *ClientWithResponses) ProjectLimitsListWithResponse(ctx context.Context,
 }
 
 type ProjectLimitsListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagLimitSchema
 	Error        *ApiError
@@ -15513,26 +16485,40 @@ func (r ProjectLimitsListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectLimitsListResponse(rsp *http.Response) (*ProjectLimitsListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectLimitsListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15546,9 +16532,10 @@ func ParseProjectLimitsListResponse(rsp *http.Response) (*ProjectLimitsListRespo
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15563,7 +16550,7 @@ func (c *ClientWithResponses) ProjectPatchLimitsWithResponse(ctx context.Context
 }
 
 type ProjectPatchLimitsResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -15580,26 +16567,40 @@ func (r ProjectPatchLimitsResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectPatchLimitsResponse(rsp *http.Response) (*ProjectPatchLimitsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectPatchLimitsResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15613,9 +16614,10 @@ func ParseProjectPatchLimitsResponse(rsp *http.Response) (*ProjectPatchLimitsRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15630,7 +16632,7 @@ func (c *ClientWithResponses) LoadBalancerListWithResponse(ctx context.Context,
 }
 
 type LoadBalancerListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListLBDetailResponseSchema0500f4e8
 	Error        *ApiError
@@ -15647,26 +16649,40 @@ func (r LoadBalancerListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerListResponse(rsp *http.Response) (*LoadBalancerListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15680,9 +16696,10 @@ func ParseLoadBalancerListResponse(rsp *http.Response) (*LoadBalancerListRespons
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15697,7 +16714,7 @@ func (c *ClientWithResponses) LoadBalancerCreateWithResponse(ctx context.Context
 }
 
 type LoadBalancerCreateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchemaE0030a52
 	Error        *ApiError
@@ -15714,26 +16731,40 @@ func (r LoadBalancerCreateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseLoadBalancerCreateResponse(rsp *http.Response) (*LoadBalancerCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &LoadBalancerCreateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15747,9 +16778,10 @@ func ParseLoadBalancerCreateResponse(rsp *http.Response) (*LoadBalancerCreateRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15764,7 +16796,7 @@ func (c *ClientWithResponses) ProjectRuleListWithResponse(ctx context.Context, o
 }
 
 type ProjectRuleListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListRuleDetailResponseSchema41873bbf
 	Error        *ApiError
@@ -15781,26 +16813,40 @@ func (r ProjectRuleListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectRuleListResponse(rsp *http.Response) (*ProjectRuleListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectRuleListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15814,9 +16860,10 @@ func ParseProjectRuleListResponse(rsp *http.Response) (*ProjectRuleListResponse,
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15831,7 +16878,7 @@ func (c *ClientWithResponses) ProjectLocalDisksListWithResponse(ctx context.Cont
 }
 
 type ProjectLocalDisksListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagLocalDiskListSchema
 	Error        *ApiError
@@ -15848,26 +16895,40 @@ func (r ProjectLocalDisksListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectLocalDisksListResponse(rsp *http.Response) (*ProjectLocalDisksListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectLocalDisksListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15881,9 +16942,10 @@ func ParseProjectLocalDisksListResponse(rsp *http.Response) (*ProjectLocalDisksL
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15898,7 +16960,7 @@ func (c *ClientWithResponses) NetworksListWithResponse(ctx context.Context, obje
 }
 
 type NetworksListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *NetworkPagSchema
 	Error        *ApiError
@@ -15915,26 +16977,40 @@ func (r NetworksListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseNetworksListResponse(rsp *http.Response) (*NetworksListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &NetworksListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -15948,9 +17024,10 @@ func ParseNetworksListResponse(rsp *http.Response) (*NetworksListResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -15965,7 +17042,7 @@ func (c *ClientWithResponses) ProjectInfrastructureModuleConstantsWithResponse(c
 }
 
 type ProjectInfrastructureModuleConstantsResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *InfrastructureModuleConstantsSchema
 	Error        *ApiError
@@ -15982,26 +17059,40 @@ func (r ProjectInfrastructureModuleConstantsResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectInfrastructureModuleConstantsResponse(rsp *http.Response) (*ProjectInfrastructureModuleConstantsResponse, error) {
+	defer func() { _ = rsp.Body.Close() }()
+	response := &ProjectInfrastructureModuleConstantsResponse{
+		HTTPResponse: rsp,
+	}
+
+	status := rsp.StatusCode
+	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
 	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-
-	response := &ProjectInfrastructureModuleConstantsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	if retain {
+		response.Body = bodyBytes
 	}
 
-	status := rsp.StatusCode
-	contentType := rsp.Header.Get("Content-Type")
-
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16015,9 +17106,10 @@ func ParseProjectInfrastructureModuleConstantsResponse(rsp *http.Response) (*Pro
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16032,7 +17124,7 @@ func (c *ClientWithResponses) ProjectRecipesWithResponse(ctx context.Context, ob
 }
 
 type ProjectRecipesResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagRecipeSchema
 	Error        *ApiError
@@ -16049,26 +17141,40 @@ func (r ProjectRecipesResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectRecipesResponse(rsp *http.Response) (*ProjectRecipesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectRecipesResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16082,9 +17188,10 @@ func ParseProjectRecipesResponse(rsp *http.Response) (*ProjectRecipesResponse, e
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16099,7 +17206,7 @@ func (c *ClientWithResponses) S3UsersListWithResponse(ctx context.Context, objec
 }
 
 type S3UsersListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListS3UserSchema003d5c52
 	Error        *ApiError
@@ -16116,26 +17223,40 @@ func (r S3UsersListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3UsersListResponse(rsp *http.Response) (*S3UsersListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3UsersListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16149,9 +17270,10 @@ func ParseS3UsersListResponse(rsp *http.Response) (*S3UsersListResponse, error)
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16166,7 +17288,7 @@ func (c *ClientWithResponses) S3UserCreateWithResponse(ctx context.Context, obje
 }
 
 type S3UserCreateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchemaCbf615fe
 	Error        *ApiError
@@ -16183,26 +17305,40 @@ func (r S3UserCreateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3UserCreateResponse(rsp *http.Response) (*S3UserCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3UserCreateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16216,9 +17352,10 @@ func ParseS3UserCreateResponse(rsp *http.Response) (*S3UserCreateResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16233,7 +17370,7 @@ func (c *ClientWithResponses) ProjectServerListWithResponse(ctx context.Context,
 }
 
 type ProjectServerListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagServersSchema
 	Error        *ApiError
@@ -16250,26 +17387,40 @@ func (r ProjectServerListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectServerListResponse(rsp *http.Response) (*ProjectServerListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectServerListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16283,9 +17434,10 @@ func ParseProjectServerListResponse(rsp *http.Response) (*ProjectServerListRespo
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16300,7 +17452,7 @@ func (c *ClientWithResponses) ServerCreateWithResponse(ctx context.Context, obje
 }
 
 type ServerCreateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchemaD2b1f0f4
 	Error        *ApiError
@@ -16317,26 +17469,40 @@ func (r ServerCreateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerCreateResponse(rsp *http.Response) (*ServerCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerCreateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16350,9 +17516,10 @@ func ParseServerCreateResponse(rsp *http.Response) (*ServerCreateResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16367,7 +17534,7 @@ func (c *ClientWithResponses) ProjectServerConfigDepWithResponse(ctx context.Con
 }
 
 type ProjectServerConfigDepResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagConfigDepSchema
 	Error        *ApiError
@@ -16384,26 +17551,40 @@ func (r ProjectServerConfigDepResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectServerConfigDepResponse(rsp *http.Response) (*ProjectServerConfigDepResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectServerConfigDepResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16417,9 +17598,10 @@ func ParseProjectServerConfigDepResponse(rsp *http.Response) (*ProjectServerConf
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16434,7 +17616,7 @@ func (c *ClientWithResponses) SnapshotsListWithResponse(ctx context.Context, obj
 }
 
 type SnapshotsListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *SnapshotListSchema
 	Error        *ApiError
@@ -16451,26 +17633,40 @@ func (r SnapshotsListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseSnapshotsListResponse(rsp *http.Response) (*SnapshotsListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &SnapshotsListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16484,9 +17680,10 @@ func ParseSnapshotsListResponse(rsp *http.Response) (*SnapshotsListResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16501,7 +17698,7 @@ func (c *ClientWithResponses) ProjectStartWithResponse(ctx context.Context, obje
 }
 
 type ProjectStartResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -16518,26 +17715,40 @@ func (r ProjectStartResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectStartResponse(rsp *http.Response) (*ProjectStartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectStartResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16551,9 +17762,10 @@ func ParseProjectStartResponse(rsp *http.Response) (*ProjectStartResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16568,7 +17780,7 @@ func (c *ClientWithResponses) ProjectStopWithResponse(ctx context.Context, objec
 }
 
 type ProjectStopResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -16585,26 +17797,40 @@ func (r ProjectStopResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectStopResponse(rsp *http.Response) (*ProjectStopResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectStopResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16618,9 +17844,10 @@ func ParseProjectStopResponse(rsp *http.Response) (*ProjectStopResponse, error)
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16635,7 +17862,7 @@ func (c *ClientWithResponses) ProjectVolumesListWithResponse(ctx context.Context
 }
 
 type ProjectVolumesListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagVolumeSchema
 	Error        *ApiError
@@ -16652,26 +17879,40 @@ func (r ProjectVolumesListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectVolumesListResponse(rsp *http.Response) (*ProjectVolumesListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectVolumesListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16685,9 +17926,10 @@ func ParseProjectVolumesListResponse(rsp *http.Response) (*ProjectVolumesListRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16702,7 +17944,7 @@ func (c *ClientWithResponses) VolumeCreateWithResponse(ctx context.Context, obje
 }
 
 type VolumeCreateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *VolumeCreateSchema
 	Error        *ApiError
@@ -16719,26 +17961,40 @@ func (r VolumeCreateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVolumeCreateResponse(rsp *http.Response) (*VolumeCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VolumeCreateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16752,9 +18008,10 @@ func ParseVolumeCreateResponse(rsp *http.Response) (*VolumeCreateResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16769,7 +18026,7 @@ func (c *ClientWithResponses) ProjectVrouterListWithResponse(ctx context.Context
 }
 
 type ProjectVrouterListResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *PagVroutersSchema
 	Error        *ApiError
@@ -16786,26 +18043,40 @@ func (r ProjectVrouterListResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseProjectVrouterListResponse(rsp *http.Response) (*ProjectVrouterListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ProjectVrouterListResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16819,9 +18090,10 @@ func ParseProjectVrouterListResponse(rsp *http.Response) (*ProjectVrouterListRes
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16836,7 +18108,7 @@ func (c *ClientWithResponses) VrouterCreateWithResponse(ctx context.Context, obj
 }
 
 type VrouterCreateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *VrouterCreateSchema
 	Error        *ApiError
@@ -16853,26 +18125,40 @@ func (r VrouterCreateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVrouterCreateResponse(rsp *http.Response) (*VrouterCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VrouterCreateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16886,9 +18172,10 @@ func ParseVrouterCreateResponse(rsp *http.Response) (*VrouterCreateResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -16903,7 +18190,7 @@ func (c *ClientWithResponses) S3UserDeleteWithResponse(ctx context.Context, obje
 }
 
 type S3UserDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -16919,26 +18206,29 @@ func (r S3UserDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3UserDeleteResponse(rsp *http.Response) (*S3UserDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3UserDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -16964,7 +18254,7 @@ func (c *ClientWithResponses) S3UserUpdateWithResponse(ctx context.Context, obje
 }
 
 type S3UserUpdateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -16981,26 +18271,40 @@ func (r S3UserUpdateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3UserUpdateResponse(rsp *http.Response) (*S3UserUpdateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3UserUpdateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17014,9 +18318,10 @@ func ParseS3UserUpdateResponse(rsp *http.Response) (*S3UserUpdateResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17031,7 +18336,7 @@ func (c *ClientWithResponses) S3GetUserKeysWithResponse(ctx context.Context, obj
 }
 
 type S3GetUserKeysResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedS3UserKeysSchema72e327cc
 	Error        *ApiError
@@ -17048,26 +18353,40 @@ func (r S3GetUserKeysResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3GetUserKeysResponse(rsp *http.Response) (*S3GetUserKeysResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3GetUserKeysResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17081,9 +18400,10 @@ func ParseS3GetUserKeysResponse(rsp *http.Response) (*S3GetUserKeysResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17098,7 +18418,7 @@ func (c *ClientWithResponses) S3GenUserKeysWithResponse(ctx context.Context, obj
 }
 
 type S3GenUserKeysResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedS3UserCreateKeysSchema2b59820e
 	Error        *ApiError
@@ -17115,26 +18435,40 @@ func (r S3GenUserKeysResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3GenUserKeysResponse(rsp *http.Response) (*S3GenUserKeysResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3GenUserKeysResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17148,9 +18482,10 @@ func ParseS3GenUserKeysResponse(rsp *http.Response) (*S3GenUserKeysResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17165,7 +18500,7 @@ func (c *ClientWithResponses) S3UserDetailsWithResponse(ctx context.Context, obj
 }
 
 type S3UserDetailsResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedS3UserSchemaC33a41de
 	Error        *ApiError
@@ -17182,26 +18517,40 @@ func (r S3UserDetailsResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3UserDetailsResponse(rsp *http.Response) (*S3UserDetailsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3UserDetailsResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17215,9 +18564,10 @@ func ParseS3UserDetailsResponse(rsp *http.Response) (*S3UserDetailsResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17232,7 +18582,7 @@ func (c *ClientWithResponses) S3UserUpdateQuotaWithResponse(ctx context.Context,
 }
 
 type S3UserUpdateQuotaResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -17249,26 +18599,40 @@ func (r S3UserUpdateQuotaResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3UserUpdateQuotaResponse(rsp *http.Response) (*S3UserUpdateQuotaResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3UserUpdateQuotaResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17282,9 +18646,10 @@ func ParseS3UserUpdateQuotaResponse(rsp *http.Response) (*S3UserUpdateQuotaRespo
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17299,7 +18664,7 @@ func (c *ClientWithResponses) S3UserSuspendWithResponse(ctx context.Context, obj
 }
 
 type S3UserSuspendResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -17316,26 +18681,40 @@ func (r S3UserSuspendResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3UserSuspendResponse(rsp *http.Response) (*S3UserSuspendResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3UserSuspendResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17349,9 +18728,10 @@ func ParseS3UserSuspendResponse(rsp *http.Response) (*S3UserSuspendResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17366,7 +18746,7 @@ func (c *ClientWithResponses) S3UserUnsuspendWithResponse(ctx context.Context, o
 }
 
 type S3UserUnsuspendResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -17383,26 +18763,40 @@ func (r S3UserUnsuspendResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseS3UserUnsuspendResponse(rsp *http.Response) (*S3UserUnsuspendResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &S3UserUnsuspendResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17416,9 +18810,10 @@ func ParseS3UserUnsuspendResponse(rsp *http.Response) (*S3UserUnsuspendResponse,
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17433,7 +18828,7 @@ func (c *ClientWithResponses) ServerDeleteWithResponse(ctx context.Context, obje
 }
 
 type ServerDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -17449,26 +18844,29 @@ func (r ServerDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerDeleteResponse(rsp *http.Response) (*ServerDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17494,7 +18892,7 @@ func (c *ClientWithResponses) ServerUpdateWithResponse(ctx context.Context, obje
 }
 
 type ServerUpdateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -17511,26 +18909,40 @@ func (r ServerUpdateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerUpdateResponse(rsp *http.Response) (*ServerUpdateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerUpdateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17544,9 +18956,10 @@ func ParseServerUpdateResponse(rsp *http.Response) (*ServerUpdateResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17561,7 +18974,7 @@ func (c *ClientWithResponses) ServerConsoleWithResponse(ctx context.Context, obj
 }
 
 type ServerConsoleResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ServerConsoleSchema
 	Error        *ApiError
@@ -17578,26 +18991,40 @@ func (r ServerConsoleResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerConsoleResponse(rsp *http.Response) (*ServerConsoleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerConsoleResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17611,9 +19038,10 @@ func ParseServerConsoleResponse(rsp *http.Response) (*ServerConsoleResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17628,7 +19056,7 @@ func (c *ClientWithResponses) ServerDetailWithResponse(ctx context.Context, obje
 }
 
 type ServerDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ServerDetailSchema
 	Error        *ApiError
@@ -17645,26 +19073,40 @@ func (r ServerDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerDetailResponse(rsp *http.Response) (*ServerDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17678,9 +19120,10 @@ func ParseServerDetailResponse(rsp *http.Response) (*ServerDetailResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17695,7 +19138,7 @@ func (c *ClientWithResponses) ServerLicensesWithResponse(ctx context.Context, ob
 }
 
 type ServerLicensesResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *ListLicenseSchema16073ca9
 	Error        *ApiError
@@ -17712,26 +19155,40 @@ func (r ServerLicensesResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerLicensesResponse(rsp *http.Response) (*ServerLicensesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerLicensesResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17745,9 +19202,10 @@ func ParseServerLicensesResponse(rsp *http.Response) (*ServerLicensesResponse, e
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17762,7 +19220,7 @@ func (c *ClientWithResponses) ServerAddLicenseWithResponse(ctx context.Context,
 }
 
 type ServerAddLicenseResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *DetailedIdResponseSchemaC22d51af
 	Error        *ApiError
@@ -17779,26 +19237,40 @@ func (r ServerAddLicenseResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerAddLicenseResponse(rsp *http.Response) (*ServerAddLicenseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerAddLicenseResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17812,9 +19284,10 @@ func ParseServerAddLicenseResponse(rsp *http.Response) (*ServerAddLicenseRespons
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17829,7 +19302,7 @@ func (c *ClientWithResponses) ServerChangePasswordWithResponse(ctx context.Conte
 }
 
 type ServerChangePasswordResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -17846,26 +19319,40 @@ func (r ServerChangePasswordResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerChangePasswordResponse(rsp *http.Response) (*ServerChangePasswordResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerChangePasswordResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17879,9 +19366,10 @@ func ParseServerChangePasswordResponse(rsp *http.Response) (*ServerChangePasswor
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17896,7 +19384,7 @@ func (c *ClientWithResponses) ServerRebootWithResponse(ctx context.Context, obje
 }
 
 type ServerRebootResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -17913,26 +19401,40 @@ func (r ServerRebootResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerRebootResponse(rsp *http.Response) (*ServerRebootResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerRebootResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -17946,9 +19448,10 @@ func ParseServerRebootResponse(rsp *http.Response) (*ServerRebootResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -17963,7 +19466,7 @@ func (c *ClientWithResponses) ServerRescueWithResponse(ctx context.Context, obje
 }
 
 type ServerRescueResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -17980,26 +19483,40 @@ func (r ServerRescueResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerRescueResponse(rsp *http.Response) (*ServerRescueResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerRescueResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18013,9 +19530,10 @@ func ParseServerRescueResponse(rsp *http.Response) (*ServerRescueResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18030,7 +19548,7 @@ func (c *ClientWithResponses) ServerResizeWithResponse(ctx context.Context, obje
 }
 
 type ServerResizeResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -18047,26 +19565,40 @@ func (r ServerResizeResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerResizeResponse(rsp *http.Response) (*ServerResizeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerResizeResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18080,9 +19612,10 @@ func ParseServerResizeResponse(rsp *http.Response) (*ServerResizeResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18097,7 +19630,7 @@ func (c *ClientWithResponses) CreateServerSnapshotWithResponse(ctx context.Conte
 }
 
 type CreateServerSnapshotResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *SnapshotCreateSchema
 	Error        *ApiError
@@ -18107,33 +19640,47 @@ func (r CreateServerSnapshotResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
+	return 0
+}
+
+// ParseCreateServerSnapshotResponse parses an HTTP response. On a non-2xx status it populates
+// the Error field AND returns it as the error, so callers can use the idiomatic
+// `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
+func ParseCreateServerSnapshotResponse(rsp *http.Response) (*CreateServerSnapshotResponse, error) {
+	defer func() { _ = rsp.Body.Close() }()
+	response := &CreateServerSnapshotResponse{
+		HTTPResponse: rsp,
+	}
+
+	status := rsp.StatusCode
+	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
 
-// ParseCreateServerSnapshotResponse parses an HTTP response. On a non-2xx status it populates
-// the Error field AND returns it as the error, so callers can use the idiomatic
-// `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
-func ParseCreateServerSnapshotResponse(rsp *http.Response) (*CreateServerSnapshotResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-
-	response := &CreateServerSnapshotResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	if retain {
+		response.Body = bodyBytes
 	}
 
-	status := rsp.StatusCode
-	contentType := rsp.Header.Get("Content-Type")
-
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18147,9 +19694,10 @@ func ParseCreateServerSnapshotResponse(rsp *http.Response) (*CreateServerSnapsho
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18164,7 +19712,7 @@ func (c *ClientWithResponses) ServerStartWithResponse(ctx context.Context, objec
 }
 
 type ServerStartResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -18181,26 +19729,40 @@ func (r ServerStartResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerStartResponse(rsp *http.Response) (*ServerStartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerStartResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18214,9 +19776,10 @@ func ParseServerStartResponse(rsp *http.Response) (*ServerStartResponse, error)
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18231,7 +19794,7 @@ func (c *ClientWithResponses) ServerStopWithResponse(ctx context.Context, object
 }
 
 type ServerStopResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -18248,26 +19811,40 @@ func (r ServerStopResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseServerStopResponse(rsp *http.Response) (*ServerStopResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &ServerStopResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18281,9 +19858,10 @@ func ParseServerStopResponse(rsp *http.Response) (*ServerStopResponse, error) {
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18298,7 +19876,7 @@ func (c *ClientWithResponses) SnapshotDeleteWithResponse(ctx context.Context, ob
 }
 
 type SnapshotDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -18314,26 +19892,29 @@ func (r SnapshotDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseSnapshotDeleteResponse(rsp *http.Response) (*SnapshotDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &SnapshotDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18359,7 +19940,7 @@ func (c *ClientWithResponses) SnapshotDetailsWithResponse(ctx context.Context, o
 }
 
 type SnapshotDetailsResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *SnapshotDetailSchema
 	Error        *ApiError
@@ -18376,26 +19957,40 @@ func (r SnapshotDetailsResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseSnapshotDetailsResponse(rsp *http.Response) (*SnapshotDetailsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &SnapshotDetailsResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18409,9 +20004,10 @@ func ParseSnapshotDetailsResponse(rsp *http.Response) (*SnapshotDetailsResponse,
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18426,7 +20022,7 @@ func (c *ClientWithResponses) SnapshotRestoreWithResponse(ctx context.Context, o
 }
 
 type SnapshotRestoreResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *SnapshotRestoreSchema
 	Error        *ApiError
@@ -18443,26 +20039,40 @@ func (r SnapshotRestoreResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseSnapshotRestoreResponse(rsp *http.Response) (*SnapshotRestoreResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &SnapshotRestoreResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18476,9 +20086,10 @@ func ParseSnapshotRestoreResponse(rsp *http.Response) (*SnapshotRestoreResponse,
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18493,7 +20104,7 @@ func (c *ClientWithResponses) AccountStatWithResponse(ctx context.Context, reqEd
 }
 
 type AccountStatResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *AccountStatSchema
 	Error        *ApiError
@@ -18510,26 +20121,40 @@ func (r AccountStatResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseAccountStatResponse(rsp *http.Response) (*AccountStatResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &AccountStatResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18543,9 +20168,10 @@ func ParseAccountStatResponse(rsp *http.Response) (*AccountStatResponse, error)
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18560,7 +20186,7 @@ func (c *ClientWithResponses) VolumeDeleteWithResponse(ctx context.Context, obje
 }
 
 type VolumeDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -18576,26 +20202,29 @@ func (r VolumeDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVolumeDeleteResponse(rsp *http.Response) (*VolumeDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VolumeDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18621,7 +20250,7 @@ func (c *ClientWithResponses) VolumeUpdateWithResponse(ctx context.Context, obje
 }
 
 type VolumeUpdateResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -18638,26 +20267,40 @@ func (r VolumeUpdateResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVolumeUpdateResponse(rsp *http.Response) (*VolumeUpdateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VolumeUpdateResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18671,9 +20314,10 @@ func ParseVolumeUpdateResponse(rsp *http.Response) (*VolumeUpdateResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18688,7 +20332,7 @@ func (c *ClientWithResponses) VolumeAttachWithResponse(ctx context.Context, obje
 }
 
 type VolumeAttachResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -18705,26 +20349,40 @@ func (r VolumeAttachResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVolumeAttachResponse(rsp *http.Response) (*VolumeAttachResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VolumeAttachResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18738,9 +20396,10 @@ func ParseVolumeAttachResponse(rsp *http.Response) (*VolumeAttachResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18755,7 +20414,7 @@ func (c *ClientWithResponses) VolumeDetachWithResponse(ctx context.Context, obje
 }
 
 type VolumeDetachResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -18772,26 +20431,40 @@ func (r VolumeDetachResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVolumeDetachResponse(rsp *http.Response) (*VolumeDetachResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VolumeDetachResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18805,9 +20478,10 @@ func ParseVolumeDetachResponse(rsp *http.Response) (*VolumeDetachResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18822,7 +20496,7 @@ func (c *ClientWithResponses) VolumeDetailWithResponse(ctx context.Context, obje
 }
 
 type VolumeDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *VolumeDetailSchema
 	Error        *ApiError
@@ -18839,26 +20513,40 @@ func (r VolumeDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVolumeDetailResponse(rsp *http.Response) (*VolumeDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VolumeDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18872,9 +20560,10 @@ func ParseVolumeDetailResponse(rsp *http.Response) (*VolumeDetailResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18889,7 +20578,7 @@ func (c *ClientWithResponses) VolumeExtendWithResponse(ctx context.Context, obje
 }
 
 type VolumeExtendResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -18906,26 +20595,40 @@ func (r VolumeExtendResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVolumeExtendResponse(rsp *http.Response) (*VolumeExtendResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VolumeExtendResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -18939,9 +20642,10 @@ func ParseVolumeExtendResponse(rsp *http.Response) (*VolumeExtendResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -18956,7 +20660,7 @@ func (c *ClientWithResponses) VrouterDeleteWithResponse(ctx context.Context, obj
 }
 
 type VrouterDeleteResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	Error        *ApiError
 }
@@ -18972,26 +20676,29 @@ func (r VrouterDeleteResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVrouterDeleteResponse(rsp *http.Response) (*VrouterDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VrouterDeleteResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -19017,7 +20724,7 @@ func (c *ClientWithResponses) VrouterDetailWithResponse(ctx context.Context, obj
 }
 
 type VrouterDetailResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *VrouterDetailSchema
 	Error        *ApiError
@@ -19034,26 +20741,40 @@ func (r VrouterDetailResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVrouterDetailResponse(rsp *http.Response) (*VrouterDetailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VrouterDetailResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -19067,9 +20788,10 @@ func ParseVrouterDetailResponse(rsp *http.Response) (*VrouterDetailResponse, err
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -19084,7 +20806,7 @@ func (c *ClientWithResponses) VrouterStartWithResponse(ctx context.Context, obje
 }
 
 type VrouterStartResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -19101,26 +20823,40 @@ func (r VrouterStartResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVrouterStartResponse(rsp *http.Response) (*VrouterStartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VrouterStartResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -19134,9 +20870,10 @@ func ParseVrouterStartResponse(rsp *http.Response) (*VrouterStartResponse, error
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
@@ -19151,7 +20888,7 @@ func (c *ClientWithResponses) VrouterStopWithResponse(ctx context.Context, objec
 }
 
 type VrouterStopResponse struct {
-	Body         []byte
+	Body         []byte // nil when the raw body is not retained, see WithoutRawBody
 	HTTPResponse *http.Response
 	OK           *interface{}
 	Error        *ApiError
@@ -19168,26 +20905,40 @@ func (r VrouterStopResponse) StatusCode() int {
 // the Error field AND returns it as the error, so callers can use the idiomatic
 // `resp, err := ...WithResponse(...); if cloapi.IsNotFound(err) { ... }` pattern.
 func ParseVrouterStopResponse(rsp *http.Response) (*VrouterStopResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
 	response := &VrouterStopResponse{
-		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	status := rsp.StatusCode
 	contentType := rsp.Header.Get("Content-Type")
+	retain := retainBody(rsp)
+
+	// With no raw body to keep, a success response is decoded straight off the
+	// wire instead of being buffered first, unless strict decoding inspects it.
+	if !retain && !checksDrift(rsp) && status >= 200 && status < 300 {
+		if strings.Contains(contentType, "json") {
+			if err := jsonCodec.Decode(rsp.Body, &response.OK); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
+			}
+		}
+		return response, nil
+	}
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if retain {
+		response.Body = bodyBytes
+	}
 
 	if status >= 400 {
 		// Seed Code with the HTTP status; a "code" field in the body overrides it.
 		// This mirrors the v2 DefaultError semantics that consumers classify on.
 		apiErr := &ApiError{Code: status}
 		if strings.Contains(contentType, "json") {
-			if e := json.Unmarshal(bodyBytes, apiErr); e != nil {
+			if e := jsonCodec.Unmarshal(bodyBytes, apiErr); e != nil {
 				apiErr = &ApiError{
 					Message:     "failed to unmarshal error response",
 					Description: string(bodyBytes),
@@ -19201,10 +20952,1212 @@ func ParseVrouterStopResponse(rsp *http.Response) (*VrouterStopResponse, error)
 		return response, apiErr
 	}
 	if strings.Contains(contentType, "json") && status >= 200 && status < 300 && len(bodyBytes) > 0 {
-		if err := json.Unmarshal(bodyBytes, &response.OK); err != nil {
+		if err := jsonCodec.Unmarshal(bodyBytes, &response.OK); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal success response: %w", err)
 		}
+		reportDrift(rsp, bodyBytes, response.OK)
 	}
 
 	return response, nil
 }
+
+// Operations describes every operation of the API in spec order: how it is
+// called and what it exchanges, for middleware and tools that need to know
+// without reflection. Look entries up with OperationByID or MatchOperation.
+var Operations = []Operation{
+	{
+		ID:           "AddressDelete",
+		Method:       "DELETE",
+		Path:         "/v2/addresses/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "AddressAttach",
+		Method:       "POST",
+		Path:         "/v2/addresses/{object_id}/attach",
+		PathParams:   []string{"object_id"},
+		RequestType:  "AddressAttachJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(AddressAttachJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "AddressChangeBandwidth",
+		Method:       "POST",
+		Path:         "/v2/addresses/{object_id}/bandwidth",
+		PathParams:   []string{"object_id"},
+		RequestType:  "AddressChangeBandwidthJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(AddressChangeBandwidthJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "AddressDetach",
+		Method:       "POST",
+		Path:         "/v2/addresses/{object_id}/detach",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "AddressDetail",
+		Method:       "GET",
+		Path:         "/v2/addresses/{object_id}/detail",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "AddressDetailSchema",
+		List:         false,
+	},
+	{
+		ID:           "AddressSetPrimary",
+		Method:       "POST",
+		Path:         "/v2/addresses/{object_id}/primary",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "AddressEditPtr",
+		Method:       "PUT",
+		Path:         "/v2/addresses/{object_id}/ptr",
+		PathParams:   []string{"object_id"},
+		RequestType:  "AddressEditPtrJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(AddressEditPtrJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "AccountBalance",
+		Method:       "GET",
+		Path:         "/v2/balance",
+		PathParams:   []string{},
+		RequestType:  "",
+		ResponseType: "DetailedAccountBalanceSchemaE14b0754",
+		List:         false,
+	},
+	{
+		ID:           "DbaasBackupDelete",
+		Method:       "DELETE",
+		Path:         "/v2/dbaas/backups/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "DbaasBackupDeleteJSONRequestBody",
+		ResponseType: "",
+		newBody:      func() any { return new(DbaasBackupDeleteJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "DbaasBackupDetail",
+		Method:       "GET",
+		Path:         "/v2/dbaas/backups/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedDbaasBackupSchema10e1206e",
+		List:         false,
+	},
+	{
+		ID:           "DbaasBackupDownload",
+		Method:       "POST",
+		Path:         "/v2/dbaas/backups/{object_id}/download",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedBackupDownloadUrlSchemaB4acf4f5",
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterDelete",
+		Method:       "DELETE",
+		Path:         "/v2/dbaas/clusters/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterDetail",
+		Method:       "GET",
+		Path:         "/v2/dbaas/clusters/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedDbaasClusterSchema0b036303",
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterUpdate",
+		Method:       "PATCH",
+		Path:         "/v2/dbaas/clusters/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "DbaasClusterUpdateJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(DbaasClusterUpdateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterBackup",
+		Method:       "POST",
+		Path:         "/v2/dbaas/clusters/{object_id}/backup/create",
+		PathParams:   []string{"object_id"},
+		RequestType:  "DbaasClusterBackupJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchemaC277c867",
+		newBody:      func() any { return new(DbaasClusterBackupJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterBackupDisable",
+		Method:       "POST",
+		Path:         "/v2/dbaas/clusters/{object_id}/backup/disable",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterBackupEnable",
+		Method:       "POST",
+		Path:         "/v2/dbaas/clusters/{object_id}/backup/enable",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterConfig",
+		Method:       "GET",
+		Path:         "/v2/dbaas/clusters/{object_id}/configuration",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedDbaasClusterConfigSchemaC07bbcb4",
+		List:         false,
+	},
+	{
+		ID:           "ClusterDbaasDatabasesList",
+		Method:       "GET",
+		Path:         "/v2/dbaas/clusters/{object_id}/databases",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListDbaasDatababaseSchemaF477384d",
+		List:         true,
+	},
+	{
+		ID:           "ClusterAddDatabase",
+		Method:       "POST",
+		Path:         "/v2/dbaas/clusters/{object_id}/databases",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ClusterAddDatabaseJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchema479878c0",
+		newBody:      func() any { return new(ClusterAddDatabaseJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ClusterDbaasNodesList",
+		Method:       "GET",
+		Path:         "/v2/dbaas/clusters/{object_id}/nodes",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListDbaasNodeSchema9b10c181",
+		List:         true,
+	},
+	{
+		ID:           "DbaasClusterResize",
+		Method:       "POST",
+		Path:         "/v2/dbaas/clusters/{object_id}/resize/resources",
+		PathParams:   []string{"object_id"},
+		RequestType:  "DbaasClusterResizeJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(DbaasClusterResizeJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterResizeStorage",
+		Method:       "POST",
+		Path:         "/v2/dbaas/clusters/{object_id}/resize/storage",
+		PathParams:   []string{"object_id"},
+		RequestType:  "DbaasClusterResizeStorageJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(DbaasClusterResizeStorageJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterStart",
+		Method:       "POST",
+		Path:         "/v2/dbaas/clusters/{object_id}/start",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "DbaasClusterStop",
+		Method:       "POST",
+		Path:         "/v2/dbaas/clusters/{object_id}/stop",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "DbaasDatabaseDelete",
+		Method:       "DELETE",
+		Path:         "/v2/dbaas/databases/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "DbaasDatabaseDetail",
+		Method:       "GET",
+		Path:         "/v2/dbaas/databases/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedDbaasDatababaseSchemaD9185bf9",
+		List:         false,
+	},
+	{
+		ID:           "ClusterDatabaseBackup",
+		Method:       "POST",
+		Path:         "/v2/dbaas/databases/{object_id}/backup/create",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ClusterDatabaseBackupJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchema07ea1a4d",
+		newBody:      func() any { return new(ClusterDatabaseBackupJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "DbaasDatabaseBackupDisable",
+		Method:       "POST",
+		Path:         "/v2/dbaas/databases/{object_id}/backup/disable",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "DbaasDatabaseBackupEnable",
+		Method:       "POST",
+		Path:         "/v2/dbaas/databases/{object_id}/backup/enable",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "DbaasRestoreAdminPassword",
+		Method:       "POST",
+		Path:         "/v2/dbaas/databases/{object_id}/restore",
+		PathParams:   []string{"object_id"},
+		RequestType:  "DbaasRestoreAdminPasswordJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(DbaasRestoreAdminPasswordJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "KeypairDelete",
+		Method:       "DELETE",
+		Path:         "/v2/keypairs/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "KeypairDetail",
+		Method:       "GET",
+		Path:         "/v2/keypairs/{object_id}/detail",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedKeyPairSchema608d05d6",
+		List:         false,
+	},
+	{
+		ID:           "AvailableLicensesList",
+		Method:       "GET",
+		Path:         "/v2/licenses",
+		PathParams:   []string{},
+		RequestType:  "",
+		ResponseType: "ListLicenseOfferSchemaC7ca8d2b",
+		List:         true,
+	},
+	{
+		ID:           "LicenseDelete",
+		Method:       "DELETE",
+		Path:         "/v2/licenses/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "LicenseDetails",
+		Method:       "GET",
+		Path:         "/v2/licenses/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedLicenseSchemaBbb1dc03",
+		List:         false,
+	},
+	{
+		ID:           "LicenseUpdate",
+		Method:       "PATCH",
+		Path:         "/v2/licenses/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "LicenseUpdateJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(LicenseUpdateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "AccountLimits",
+		Method:       "GET",
+		Path:         "/v2/limits",
+		PathParams:   []string{},
+		RequestType:  "",
+		ResponseType: "AccountLimitSchema",
+		List:         true,
+	},
+	{
+		ID:           "AccountPatchLimits",
+		Method:       "PATCH",
+		Path:         "/v2/limits",
+		PathParams:   []string{},
+		RequestType:  "AccountPatchLimitsJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(AccountPatchLimitsJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "AccountProjectsLimits",
+		Method:       "GET",
+		Path:         "/v2/limits/projects",
+		PathParams:   []string{},
+		RequestType:  "",
+		ResponseType: "AccountProjectLimitSchema",
+		List:         true,
+	},
+	{
+		ID:           "RuleDelete",
+		Method:       "DELETE",
+		Path:         "/v2/loadbalancers/rules/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "RuleDetail",
+		Method:       "GET",
+		Path:         "/v2/loadbalancers/rules/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedRuleDetailResponseSchemaBab89e66",
+		List:         false,
+	},
+	{
+		ID:           "LoadBalancerDelete",
+		Method:       "DELETE",
+		Path:         "/v2/loadbalancers/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "LoadBalancerRename",
+		Method:       "PATCH",
+		Path:         "/v2/loadbalancers/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "LoadBalancerRenameJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(LoadBalancerRenameJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "LoadBalancerUpdate",
+		Method:       "POST",
+		Path:         "/v2/loadbalancers/{object_id}/algorithm",
+		PathParams:   []string{"object_id"},
+		RequestType:  "LoadBalancerUpdateJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(LoadBalancerUpdateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "LoadBalancerDetail",
+		Method:       "GET",
+		Path:         "/v2/loadbalancers/{object_id}/detail",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedLBDetailResponseSchemaB0fafd3b",
+		List:         false,
+	},
+	{
+		ID:           "LoadBalancerUpdateHealthmonitor",
+		Method:       "PUT",
+		Path:         "/v2/loadbalancers/{object_id}/healthmonitor",
+		PathParams:   []string{"object_id"},
+		RequestType:  "LoadBalancerUpdateHealthmonitorJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(LoadBalancerUpdateHealthmonitorJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "RuleList",
+		Method:       "GET",
+		Path:         "/v2/loadbalancers/{object_id}/rules",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListRuleDetailResponseSchema3d329e13",
+		List:         true,
+	},
+	{
+		ID:           "RuleCreate",
+		Method:       "POST",
+		Path:         "/v2/loadbalancers/{object_id}/rules",
+		PathParams:   []string{"object_id"},
+		RequestType:  "RuleCreateJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchema506fd633",
+		newBody:      func() any { return new(RuleCreateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "LoadBalancerEnable",
+		Method:       "POST",
+		Path:         "/v2/loadbalancers/{object_id}/start",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "LoadBalancerStat",
+		Method:       "GET",
+		Path:         "/v2/loadbalancers/{object_id}/stat",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedLbStatSchemaBa0fc678",
+		List:         false,
+	},
+	{
+		ID:           "LoadBalancerStop",
+		Method:       "POST",
+		Path:         "/v2/loadbalancers/{object_id}/stop",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "LocalDiskDetail",
+		Method:       "GET",
+		Path:         "/v2/local-disks/{object_id}/detail",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "LocalDiskDetail",
+		List:         false,
+	},
+	{
+		ID:           "MaintenanceModeStatus",
+		Method:       "GET",
+		Path:         "/v2/maintenance-mode",
+		PathParams:   []string{},
+		RequestType:  "",
+		ResponseType: "MaintenanceModeSchema",
+		List:         false,
+	},
+	{
+		ID:           "ProjectList",
+		Method:       "GET",
+		Path:         "/v2/projects",
+		PathParams:   []string{},
+		RequestType:  "",
+		ResponseType: "ProjectPagListSchema",
+		List:         true,
+	},
+	{
+		ID:           "ProjectCreate",
+		Method:       "POST",
+		Path:         "/v2/projects",
+		PathParams:   []string{},
+		RequestType:  "ProjectCreateJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchemaF7a555c6",
+		newBody:      func() any { return new(ProjectCreateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ProjectDelete",
+		Method:       "DELETE",
+		Path:         "/v2/projects/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "ProjectPatchDisplayNameDescription",
+		Method:       "PATCH",
+		Path:         "/v2/projects/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ProjectPatchDisplayNameDescriptionJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(ProjectPatchDisplayNameDescriptionJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ProjectAddressesList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/addresses",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagAddressSchema",
+		List:         true,
+	},
+	{
+		ID:           "AddressCreate",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/addresses",
+		PathParams:   []string{"object_id"},
+		RequestType:  "AddressCreateJSONRequestBody",
+		ResponseType: "AddressCreateSchema",
+		newBody:      func() any { return new(AddressCreateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ProjectConsumption",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/consumption",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ConsumptionListSchema",
+		List:         true,
+	},
+	{
+		ID:           "ProjectBackupList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/dbaas/backups",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListDbaasBackupSchema499765e5",
+		List:         true,
+	},
+	{
+		ID:           "DbaasClustersList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/dbaas/clusters",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListDbaasClusterSchema583f8e35",
+		List:         true,
+	},
+	{
+		ID:           "DbaasClusterCreate",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/dbaas/clusters",
+		PathParams:   []string{"object_id"},
+		RequestType:  "DbaasClusterCreateJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchema91049310",
+		newBody:      func() any { return new(DbaasClusterCreateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ProjectDbaasDatabasesList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/dbaas/databases",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListDbaasDatababaseSchemaB43fad10",
+		List:         true,
+	},
+	{
+		ID:           "ProjectDbaasDatastores",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/dbaas/datastores",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListDatastoreSchemaCff1b68f",
+		List:         true,
+	},
+	{
+		ID:           "ProjectDbaasConfigDep",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/dbaas/related-resources",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagConfigDepSchema",
+		List:         true,
+	},
+	{
+		ID:           "ProjectDetail",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/detail",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedProjectDetailSchemaFa5e874e",
+		List:         false,
+	},
+	{
+		ID:           "ProjectImagesList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/images",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagImageSchema",
+		List:         true,
+	},
+	{
+		ID:           "KeyPairsList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/keypairs",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagKeyPairSchema",
+		List:         true,
+	},
+	{
+		ID:           "ImportKeypair",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/keypairs",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ImportKeypairJSONRequestBody",
+		ResponseType: "DetailedKeyPairSchema608d05d6",
+		newBody:      func() any { return new(ImportKeypairJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "GenerateKeypair",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/keypairs/generate",
+		PathParams:   []string{"object_id"},
+		RequestType:  "GenerateKeypairJSONRequestBody",
+		ResponseType: "DetailedGenerateKeyPairResultSchema082b209e",
+		newBody:      func() any { return new(GenerateKeypairJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ProjectLimitsList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/limits",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagLimitSchema",
+		List:         true,
+	},
+	{
+		ID:           "ProjectPatchLimits",
+		Method:       "PATCH",
+		Path:         "/v2/projects/{object_id}/limits",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ProjectPatchLimitsJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(ProjectPatchLimitsJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "LoadBalancerList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/loadbalancers",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListLBDetailResponseSchema0500f4e8",
+		List:         true,
+	},
+	{
+		ID:           "LoadBalancerCreate",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/loadbalancers",
+		PathParams:   []string{"object_id"},
+		RequestType:  "LoadBalancerCreateJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchemaE0030a52",
+		newBody:      func() any { return new(LoadBalancerCreateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ProjectRuleList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/loadbalancers/rules",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListRuleDetailResponseSchema41873bbf",
+		List:         true,
+	},
+	{
+		ID:           "ProjectLocalDisksList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/local-disks",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagLocalDiskListSchema",
+		List:         true,
+	},
+	{
+		ID:           "NetworksList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/networks",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "NetworkPagSchema",
+		List:         true,
+	},
+	{
+		ID:           "ProjectInfrastructureModuleConstants",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/params",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "InfrastructureModuleConstantsSchema",
+		List:         false,
+	},
+	{
+		ID:           "ProjectRecipes",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/recipes",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagRecipeSchema",
+		List:         true,
+	},
+	{
+		ID:           "S3UsersList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/s3/users",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListS3UserSchema003d5c52",
+		List:         true,
+	},
+	{
+		ID:           "S3UserCreate",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/s3/users",
+		PathParams:   []string{"object_id"},
+		RequestType:  "S3UserCreateJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchemaCbf615fe",
+		newBody:      func() any { return new(S3UserCreateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ProjectServerList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/servers",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagServersSchema",
+		List:         true,
+	},
+	{
+		ID:           "ServerCreate",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/servers",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ServerCreateJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchemaD2b1f0f4",
+		newBody:      func() any { return new(ServerCreateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ProjectServerConfigDep",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/servers/related-resources",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagConfigDepSchema",
+		List:         true,
+	},
+	{
+		ID:           "SnapshotsList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/snapshots",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "SnapshotListSchema",
+		List:         true,
+	},
+	{
+		ID:           "ProjectStart",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/start",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "ProjectStop",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/stop",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "ProjectVolumesList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/volumes",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagVolumeSchema",
+		List:         true,
+	},
+	{
+		ID:           "VolumeCreate",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/volumes",
+		PathParams:   []string{"object_id"},
+		RequestType:  "VolumeCreateJSONRequestBody",
+		ResponseType: "VolumeCreateSchema",
+		newBody:      func() any { return new(VolumeCreateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ProjectVrouterList",
+		Method:       "GET",
+		Path:         "/v2/projects/{object_id}/vrouters",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "PagVroutersSchema",
+		List:         true,
+	},
+	{
+		ID:           "VrouterCreate",
+		Method:       "POST",
+		Path:         "/v2/projects/{object_id}/vrouters",
+		PathParams:   []string{"object_id"},
+		RequestType:  "VrouterCreateJSONRequestBody",
+		ResponseType: "VrouterCreateSchema",
+		newBody:      func() any { return new(VrouterCreateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "S3UserDelete",
+		Method:       "DELETE",
+		Path:         "/v2/s3/users/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "S3UserUpdate",
+		Method:       "PATCH",
+		Path:         "/v2/s3/users/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "S3UserUpdateJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(S3UserUpdateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "S3GetUserKeys",
+		Method:       "GET",
+		Path:         "/v2/s3/users/{object_id}/credentials",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedS3UserKeysSchema72e327cc",
+		List:         false,
+	},
+	{
+		ID:           "S3GenUserKeys",
+		Method:       "POST",
+		Path:         "/v2/s3/users/{object_id}/credentials",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedS3UserCreateKeysSchema2b59820e",
+		List:         false,
+	},
+	{
+		ID:           "S3UserDetails",
+		Method:       "GET",
+		Path:         "/v2/s3/users/{object_id}/detail",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "DetailedS3UserSchemaC33a41de",
+		List:         false,
+	},
+	{
+		ID:           "S3UserUpdateQuota",
+		Method:       "PUT",
+		Path:         "/v2/s3/users/{object_id}/quotas",
+		PathParams:   []string{"object_id"},
+		RequestType:  "S3UserUpdateQuotaJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(S3UserUpdateQuotaJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "S3UserSuspend",
+		Method:       "POST",
+		Path:         "/v2/s3/users/{object_id}/suspend",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "S3UserUnsuspend",
+		Method:       "POST",
+		Path:         "/v2/s3/users/{object_id}/unsuspend",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "ServerDelete",
+		Method:       "DELETE",
+		Path:         "/v2/servers/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ServerDeleteJSONRequestBody",
+		ResponseType: "",
+		newBody:      func() any { return new(ServerDeleteJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ServerUpdate",
+		Method:       "PATCH",
+		Path:         "/v2/servers/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ServerUpdateJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(ServerUpdateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ServerConsole",
+		Method:       "POST",
+		Path:         "/v2/servers/{object_id}/console",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ServerConsoleSchema",
+		List:         false,
+	},
+	{
+		ID:           "ServerDetail",
+		Method:       "GET",
+		Path:         "/v2/servers/{object_id}/detail",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ServerDetailSchema",
+		List:         false,
+	},
+	{
+		ID:           "ServerLicenses",
+		Method:       "GET",
+		Path:         "/v2/servers/{object_id}/licenses",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "ListLicenseSchema16073ca9",
+		List:         true,
+	},
+	{
+		ID:           "ServerAddLicense",
+		Method:       "POST",
+		Path:         "/v2/servers/{object_id}/licenses",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ServerAddLicenseJSONRequestBody",
+		ResponseType: "DetailedIdResponseSchemaC22d51af",
+		newBody:      func() any { return new(ServerAddLicenseJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ServerChangePassword",
+		Method:       "POST",
+		Path:         "/v2/servers/{object_id}/password",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ServerChangePasswordJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(ServerChangePasswordJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ServerReboot",
+		Method:       "POST",
+		Path:         "/v2/servers/{object_id}/reboot",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "ServerRescue",
+		Method:       "POST",
+		Path:         "/v2/servers/{object_id}/rescue",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "ServerResize",
+		Method:       "POST",
+		Path:         "/v2/servers/{object_id}/resize",
+		PathParams:   []string{"object_id"},
+		RequestType:  "ServerResizeJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(ServerResizeJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "CreateServerSnapshot",
+		Method:       "POST",
+		Path:         "/v2/servers/{object_id}/snapshot",
+		PathParams:   []string{"object_id"},
+		RequestType:  "CreateServerSnapshotJSONRequestBody",
+		ResponseType: "SnapshotCreateSchema",
+		newBody:      func() any { return new(CreateServerSnapshotJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "ServerStart",
+		Method:       "POST",
+		Path:         "/v2/servers/{object_id}/start",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "ServerStop",
+		Method:       "POST",
+		Path:         "/v2/servers/{object_id}/stop",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "SnapshotDelete",
+		Method:       "DELETE",
+		Path:         "/v2/snapshots/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "SnapshotDetails",
+		Method:       "GET",
+		Path:         "/v2/snapshots/{object_id}/detail",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "SnapshotDetailSchema",
+		List:         false,
+	},
+	{
+		ID:           "SnapshotRestore",
+		Method:       "POST",
+		Path:         "/v2/snapshots/{object_id}/restore",
+		PathParams:   []string{"object_id"},
+		RequestType:  "SnapshotRestoreJSONRequestBody",
+		ResponseType: "SnapshotRestoreSchema",
+		newBody:      func() any { return new(SnapshotRestoreJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "AccountStat",
+		Method:       "GET",
+		Path:         "/v2/stat",
+		PathParams:   []string{},
+		RequestType:  "",
+		ResponseType: "AccountStatSchema",
+		List:         true,
+	},
+	{
+		ID:           "VolumeDelete",
+		Method:       "DELETE",
+		Path:         "/v2/volumes/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "VolumeDeleteJSONRequestBody",
+		ResponseType: "",
+		newBody:      func() any { return new(VolumeDeleteJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "VolumeUpdate",
+		Method:       "PATCH",
+		Path:         "/v2/volumes/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "VolumeUpdateJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(VolumeUpdateJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "VolumeAttach",
+		Method:       "POST",
+		Path:         "/v2/volumes/{object_id}/attach",
+		PathParams:   []string{"object_id"},
+		RequestType:  "VolumeAttachJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(VolumeAttachJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "VolumeDetach",
+		Method:       "POST",
+		Path:         "/v2/volumes/{object_id}/detach",
+		PathParams:   []string{"object_id"},
+		RequestType:  "VolumeDetachJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(VolumeDetachJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "VolumeDetail",
+		Method:       "GET",
+		Path:         "/v2/volumes/{object_id}/detail",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "VolumeDetailSchema",
+		List:         false,
+	},
+	{
+		ID:           "VolumeExtend",
+		Method:       "POST",
+		Path:         "/v2/volumes/{object_id}/extend",
+		PathParams:   []string{"object_id"},
+		RequestType:  "VolumeExtendJSONRequestBody",
+		ResponseType: "interface{}",
+		newBody:      func() any { return new(VolumeExtendJSONRequestBody) },
+		List:         false,
+	},
+	{
+		ID:           "VrouterDelete",
+		Method:       "DELETE",
+		Path:         "/v2/vrouters/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "",
+		List:         false,
+	},
+	{
+		ID:           "VrouterDetail",
+		Method:       "GET",
+		Path:         "/v2/vrouters/{object_id}",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "VrouterDetailSchema",
+		List:         false,
+	},
+	{
+		ID:           "VrouterStart",
+		Method:       "POST",
+		Path:         "/v2/vrouters/{object_id}/start",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+	{
+		ID:           "VrouterStop",
+		Method:       "POST",
+		Path:         "/v2/vrouters/{object_id}/stop",
+		PathParams:   []string{"object_id"},
+		RequestType:  "",
+		ResponseType: "interface{}",
+		List:         false,
+	},
+}
@@ -0,0 +1,343 @@
+package cloapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaxUserDataSize is the largest user_data the API accepts, in bytes, measured on
+// the value sent (after any encoding). It is OpenStack's 64 KiB user-data limit.
+const MaxUserDataSize = 65535
+
+// CloudConfig is a typed subset of a cloud-init #cloud-config document covering
+// what server bootstrap usually needs. Zero-valued fields are omitted.
+type CloudConfig struct {
+	Hostname string
+	// KeepDefaultUser keeps the image's default user alongside Users; cloud-init
+	// otherwise replaces it.
+	KeepDefaultUser bool
+	Users           []CloudUser
+	// SSHAuthorizedKeys are added to the default user.
+	SSHAuthorizedKeys []string
+	PackageUpdate     bool
+	PackageUpgrade    bool
+	Packages          []string
+	WriteFiles        []CloudFile
+	// RunCmd are shell commands run once, at the end of the first boot.
+	RunCmd []string
+}
+
+// CloudUser is an entry of the cloud-config users list.
+type CloudUser struct {
+	Name              string
+	Groups            []string
+	Shell             string
+	Sudo              string
+	SSHAuthorizedKeys []string
+}
+
+// CloudFile is an entry of the cloud-config write_files list.
+type CloudFile struct {
+	Path    string
+	Content string
+	Owner   string
+	// Permissions is an octal mode string such as "0644".
+	Permissions string
+	Append      bool
+}
+
+// UserDataEncoding selects how Render encodes the payload.
+type UserDataEncoding int
+
+const (
+	// UserDataPlain sends the payload as is.
+	UserDataPlain UserDataEncoding = iota
+	// UserDataGzipBase64 gzips the payload and base64-encodes the result, for
+	// payloads that would otherwise exceed MaxUserDataSize. cloud-init detects and
+	// decompresses gzip on its own.
+	UserDataGzipBase64
+)
+
+// UserData builds the user_data of ServerCreateJSONBody from a cloud-config
+// document and shell scripts. A lone cloud-config renders as "#cloud-config"
+// YAML; anything more renders as a multipart MIME archive, which cloud-init
+// unpacks part by part.
+//
+//	data, err := cloapi.NewUserData().
+//		CloudConfig(&cloapi.CloudConfig{Packages: []string{"nginx"}}).
+//		ShellScript("setup.sh", "#!/bin/sh\necho ready\n").
+//		Render()
+//	spec.UserData(data)
+type UserData struct {
+	config   *CloudConfig
+	scripts  []userScript
+	encoding UserDataEncoding
+}
+
+type userScript struct {
+	name, content string
+}
+
+// NewUserData starts an empty user data payload.
+func NewUserData() *UserData {
+	return &UserData{}
+}
+
+// CloudConfig sets the cloud-config part.
+func (u *UserData) CloudConfig(c *CloudConfig) *UserData {
+	u.config = c
+	return u
+}
+
+// ShellScript adds a script part; it runs once on first boot. The content must
+// start with a shebang line.
+func (u *UserData) ShellScript(name, content string) *UserData {
+	u.scripts = append(u.scripts, userScript{name: name, content: content})
+	return u
+}
+
+// Encoding sets the encoding of the rendered payload (default UserDataPlain).
+func (u *UserData) Encoding(e UserDataEncoding) *UserData {
+	u.encoding = e
+	return u
+}
+
+// Validate checks the parts and returns every problem at once as a
+// *ValidationError, or nil.
+func (u *UserData) Validate() error {
+	v := &ValidationError{}
+	if u.config == nil && len(u.scripts) == 0 {
+		v.add("user_data", "needs a cloud-config or at least one script")
+	}
+	if c := u.config; c != nil {
+		for i, usr := range c.Users {
+			if usr.Name == "" {
+				v.add(fmt.Sprintf("users[%d].name", i), "must not be empty")
+			}
+			for j, k := range usr.SSHAuthorizedKeys {
+				if !looksLikeSSHKey(k) {
+					v.add(fmt.Sprintf("users[%d].ssh_authorized_keys[%d]", i, j), "is not an SSH public key")
+				}
+			}
+		}
+		for i, k := range c.SSHAuthorizedKeys {
+			if !looksLikeSSHKey(k) {
+				v.add(fmt.Sprintf("ssh_authorized_keys[%d]", i), "is not an SSH public key")
+			}
+		}
+		for i, f := range c.WriteFiles {
+			if !strings.HasPrefix(f.Path, "/") {
+				v.add(fmt.Sprintf("write_files[%d].path", i), "must be absolute")
+			}
+			if f.Permissions != "" && !octalMode.MatchString(f.Permissions) {
+				v.add(fmt.Sprintf("write_files[%d].permissions", i), fmt.Sprintf("%q is not an octal mode", f.Permissions))
+			}
+		}
+		for i, cmd := range c.RunCmd {
+			if strings.TrimSpace(cmd) == "" {
+				v.add(fmt.Sprintf("runcmd[%d]", i), "must not be empty")
+			}
+		}
+	}
+	for i, s := range u.scripts {
+		if !strings.HasPrefix(s.content, "#!") {
+			v.add(fmt.Sprintf("scripts[%d]", i), fmt.Sprintf("%s must start with a shebang", s.name))
+		}
+	}
+	return v.errOrNil()
+}
+
+var octalMode = regexp.MustCompile(`^0?[0-7]{3,4}$`)
+
+func looksLikeSSHKey(k string) bool {
+	fields := strings.Fields(k)
+	return len(fields) >= 2 && (strings.HasPrefix(fields[0], "ssh-") || strings.HasPrefix(fields[0], "ecdsa-") ||
+		strings.HasPrefix(fields[0], "sk-"))
+}
+
+// Render validates and renders the payload, ready for ServerCreateJSONBody.UserData.
+// It fails if the result exceeds MaxUserDataSize.
+func (u *UserData) Render() (string, error) {
+	if err := u.Validate(); err != nil {
+		return "", err
+	}
+
+	var payload []byte
+	if len(u.scripts) == 0 {
+		payload = []byte(u.config.render())
+	} else {
+		var err error
+		if payload, err = u.renderMultipart(); err != nil {
+			return "", err
+		}
+	}
+
+	out := string(payload)
+	if u.encoding == UserDataGzipBase64 {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(payload); err != nil {
+			return "", err
+		}
+		if err := zw.Close(); err != nil {
+			return "", err
+		}
+		out = base64.StdEncoding.EncodeToString(buf.Bytes())
+	}
+	if len(out) > MaxUserDataSize {
+		return "", fmt.Errorf("cloapi: user data is %d bytes, over the %d byte limit", len(out), MaxUserDataSize)
+	}
+	return out, nil
+}
+
+func (u *UserData) renderMultipart() ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	type mimePart struct{ contentType, name, content string }
+	var parts []mimePart
+	if u.config != nil {
+		parts = append(parts, mimePart{"text/cloud-config", "cloud-config.yaml", u.config.render()})
+	}
+	for _, s := range u.scripts {
+		parts = append(parts, mimePart{"text/x-shellscript", s.name, s.content})
+	}
+	for _, p := range parts {
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Type", fmt.Sprintf("%s; charset=\"utf-8\"", p.contentType))
+		h.Set("MIME-Version", "1.0")
+		h.Set("Content-Transfer-Encoding", "7bit")
+		h.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", p.name))
+		w, err := mw.CreatePart(h)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write([]byte(p.content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", mw.Boundary())
+	out.Write(body.Bytes())
+	return out.Bytes(), nil
+}
+
+// render emits the document as YAML. The structure is fixed, so a small emitter
+// suffices: every scalar is either a plain safe word or a double-quoted string.
+func (c *CloudConfig) render() string {
+	var b strings.Builder
+	b.WriteString("#cloud-config\n")
+	if c.Hostname != "" {
+		fmt.Fprintf(&b, "hostname: %s\n", yamlScalar(c.Hostname))
+	}
+	if len(c.Users) > 0 || c.KeepDefaultUser {
+		b.WriteString("users:\n")
+		if c.KeepDefaultUser {
+			b.WriteString("  - default\n")
+		}
+		for _, u := range c.Users {
+			fmt.Fprintf(&b, "  - name: %s\n", yamlScalar(u.Name))
+			if len(u.Groups) > 0 {
+				fmt.Fprintf(&b, "    groups: %s\n", yamlScalar(strings.Join(u.Groups, ", ")))
+			}
+			if u.Shell != "" {
+				fmt.Fprintf(&b, "    shell: %s\n", yamlScalar(u.Shell))
+			}
+			if u.Sudo != "" {
+				fmt.Fprintf(&b, "    sudo: %s\n", yamlScalar(u.Sudo))
+			}
+			writeYAMLList(&b, "    ", "ssh_authorized_keys", u.SSHAuthorizedKeys)
+		}
+	}
+	writeYAMLList(&b, "", "ssh_authorized_keys", c.SSHAuthorizedKeys)
+	if c.PackageUpdate {
+		b.WriteString("package_update: true\n")
+	}
+	if c.PackageUpgrade {
+		b.WriteString("package_upgrade: true\n")
+	}
+	writeYAMLList(&b, "", "packages", c.Packages)
+	if len(c.WriteFiles) > 0 {
+		b.WriteString("write_files:\n")
+		for _, f := range c.WriteFiles {
+			fmt.Fprintf(&b, "  - path: %s\n", yamlScalar(f.Path))
+			if f.Owner != "" {
+				fmt.Fprintf(&b, "    owner: %s\n", yamlScalar(f.Owner))
+			}
+			if f.Permissions != "" {
+				// Always quoted: a bare 0644 would be read as an integer.
+				fmt.Fprintf(&b, "    permissions: %s\n", strconv.Quote(f.Permissions))
+			}
+			if f.Append {
+				b.WriteString("    append: true\n")
+			}
+			fmt.Fprintf(&b, "    content: %s\n", yamlText(f.Content, "      "))
+		}
+	}
+	writeYAMLList(&b, "", "runcmd", c.RunCmd)
+	return b.String()
+}
+
+func writeYAMLList(b *strings.Builder, indent, key string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "%s%s:\n", indent, key)
+	for _, it := range items {
+		fmt.Fprintf(b, "%s  - %s\n", indent, yamlScalar(it))
+	}
+}
+
+var yamlPlain = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_./-]*$`)
+
+// yamlReserved are plain words YAML 1.1 (which cloud-init uses) reads as
+// booleans or null.
+var yamlReserved = map[string]bool{
+	"y": true, "yes": true, "n": true, "no": true, "true": true, "false": true,
+	"on": true, "off": true, "null": true,
+}
+
+// yamlScalar returns s as a plain scalar when that is unambiguous, else as a
+// double-quoted one. Go's quoting escapes are a subset of YAML's.
+func yamlScalar(s string) string {
+	if yamlPlain.MatchString(s) && !yamlReserved[strings.ToLower(s)] {
+		return s
+	}
+	return strconv.Quote(s)
+}
+
+// yamlText renders multi-line content as a literal block when it round-trips
+// exactly, falling back to a quoted scalar.
+func yamlText(s, indent string) string {
+	if !strings.Contains(s, "\n") || strings.HasPrefix(s, " ") || strings.ContainsAny(s, "\r") {
+		return strconv.Quote(s)
+	}
+	header := "|"
+	body := s
+	switch {
+	case strings.HasSuffix(s, "\n\n"):
+		return strconv.Quote(s)
+	case strings.HasSuffix(s, "\n"):
+		body = strings.TrimSuffix(s, "\n")
+	default:
+		header = "|-"
+	}
+	lines := strings.Split(body, "\n")
+	for i, l := range lines {
+		if l != "" {
+			lines[i] = indent + l
+		}
+	}
+	return header + "\n" + strings.Join(lines, "\n")
+}
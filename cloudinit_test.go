@@ -0,0 +1,139 @@
+package cloapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+const testSSHKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBx deploy@ci"
+
+func TestCloudConfigRender(t *testing.T) {
+	data, err := NewUserData().CloudConfig(&CloudConfig{
+		Hostname:        "web-1",
+		KeepDefaultUser: true,
+		Users: []CloudUser{{
+			Name: "deploy", Groups: []string{"sudo", "docker"}, Shell: "/bin/bash",
+			Sudo: "ALL=(ALL) NOPASSWD:ALL", SSHAuthorizedKeys: []string{testSSHKey},
+		}},
+		PackageUpdate: true,
+		Packages:      []string{"nginx", "yes"},
+		WriteFiles:    []CloudFile{{Path: "/etc/motd", Content: "hello\nworld\n", Permissions: "0644"}},
+		RunCmd:        []string{"systemctl restart nginx"},
+	}).Render()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `#cloud-config
+hostname: web-1
+users:
+  - default
+  - name: deploy
+    groups: "sudo, docker"
+    shell: /bin/bash
+    sudo: "ALL=(ALL) NOPASSWD:ALL"
+    ssh_authorized_keys:
+      - "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBx deploy@ci"
+package_update: true
+packages:
+  - nginx
+  - "yes"
+write_files:
+  - path: /etc/motd
+    permissions: "0644"
+    content: |
+      hello
+      world
+runcmd:
+  - "systemctl restart nginx"
+`
+	if data != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", data, want)
+	}
+}
+
+func TestUserDataMultipart(t *testing.T) {
+	data, err := NewUserData().
+		CloudConfig(&CloudConfig{Packages: []string{"curl"}}).
+		ShellScript("setup.sh", "#!/bin/sh\necho ready\n").
+		Render()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := mail.ReadMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	var types []string
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		types = append(types, strings.Split(p.Header.Get("Content-Type"), ";")[0])
+	}
+	if strings.Join(types, ",") != "text/cloud-config,text/x-shellscript" {
+		t.Fatalf("parts = %v", types)
+	}
+}
+
+func TestUserDataGzipBase64(t *testing.T) {
+	data, err := NewUserData().
+		CloudConfig(&CloudConfig{RunCmd: []string{"true"}}).
+		Encoding(UserDataGzipBase64).
+		Render()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain, _ := io.ReadAll(zr)
+	if !strings.HasPrefix(string(plain), "#cloud-config\n") {
+		t.Fatalf("decoded = %q", plain)
+	}
+}
+
+func TestUserDataValidation(t *testing.T) {
+	_, err := NewUserData().
+		CloudConfig(&CloudConfig{
+			Users:      []CloudUser{{SSHAuthorizedKeys: []string{"not-a-key"}}},
+			WriteFiles: []CloudFile{{Path: "relative", Permissions: "rw"}},
+		}).
+		ShellScript("x.sh", "echo no shebang").
+		Render()
+	var verr *ValidationError
+	if !errors.As(err, &verr) || len(verr.Fields) != 5 {
+		t.Fatalf("err = %v, want 5 field errors", err)
+	}
+
+	big := NewUserData().CloudConfig(&CloudConfig{WriteFiles: []CloudFile{{
+		Path: "/blob", Content: strings.Repeat("x", MaxUserDataSize),
+	}}})
+	if _, err := big.Render(); err == nil {
+		t.Fatal("want size limit error")
+	}
+	if _, err := big.Encoding(UserDataGzipBase64).Render(); err != nil {
+		t.Fatalf("compressed payload should fit: %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// API is the exported surface of a Go file: symbol keys mapped to a rendering of
+// their type, signature or value, which changes whenever the symbol changes in
+// a way callers can observe. Keys read "type Foo", "field Foo.Bar",
+// "method Foo.Bar", "func Foo", "const Foo" and "var Foo". Fields of inline
+// struct types get their own keys, e.g. "field Foo.Addresses[].Id", so a field
+// added deep inside a request body is an addition, not a change of Addresses.
+type API map[string]string
+
+// ParseAPI reads the exported API of the Go file at path.
+func ParseAPI(path string) (API, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	api := API{}
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			api.addFunc(d)
+		case *ast.GenDecl:
+			api.addGen(d)
+		}
+	}
+	return api, nil
+}
+
+func (api API) addFunc(d *ast.FuncDecl) {
+	if !d.Name.IsExported() {
+		return
+	}
+	sig := types.ExprString(d.Type)
+	if d.Recv == nil {
+		api["func "+d.Name.Name] = sig
+		return
+	}
+	recv := receiverName(d.Recv.List[0].Type)
+	if ast.IsExported(recv) {
+		api["method "+recv+"."+d.Name.Name] = sig
+	}
+}
+
+func (api API) addGen(d *ast.GenDecl) {
+	for _, spec := range d.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			if s.Name.IsExported() {
+				api.addType(s)
+			}
+		case *ast.ValueSpec:
+			kind := "var"
+			if d.Tok == token.CONST {
+				kind = "const"
+			}
+			for i, name := range s.Names {
+				if !name.IsExported() {
+					continue
+				}
+				desc := ""
+				if s.Type != nil {
+					desc = types.ExprString(s.Type)
+				}
+				if kind == "const" && i < len(s.Values) {
+					desc += " = " + types.ExprString(s.Values[i])
+				}
+				api[kind+" "+name.Name] = strings.TrimSpace(desc)
+			}
+		}
+	}
+}
+
+func (api API) addType(s *ast.TypeSpec) {
+	name := s.Name.Name
+	key := "type " + name
+	if s.Assign.IsValid() {
+		api[key] = "= " + types.ExprString(s.Type)
+		return
+	}
+	switch t := s.Type.(type) {
+	case *ast.InterfaceType:
+		api[key] = "interface"
+		for _, m := range t.Methods.List {
+			if len(m.Names) == 0 {
+				api["embed "+name+"."+types.ExprString(m.Type)] = ""
+				continue
+			}
+			for _, n := range m.Names {
+				if n.IsExported() {
+					api["method "+name+"."+n.Name] = types.ExprString(m.Type)
+				}
+			}
+		}
+	default:
+		api[key] = api.typeString(s.Type, name)
+	}
+}
+
+// typeString renders expr, replacing inline struct types by "struct{…}" after
+// recording their fields under prefix.
+func (api API) typeString(expr ast.Expr, prefix string) string {
+	switch t := expr.(type) {
+	case *ast.StructType:
+		for _, f := range t.Fields.List {
+			if len(f.Names) == 0 {
+				api["embed "+prefix+"."+types.ExprString(f.Type)] = ""
+				continue
+			}
+			for _, n := range f.Names {
+				if n.IsExported() {
+					api["field "+prefix+"."+n.Name] = api.typeString(f.Type, prefix+"."+n.Name)
+				}
+			}
+		}
+		return "struct{…}"
+	case *ast.StarExpr:
+		return "*" + api.typeString(t.X, prefix)
+	case *ast.ArrayType:
+		n := ""
+		if t.Len != nil {
+			n = types.ExprString(t.Len)
+		}
+		return "[" + n + "]" + api.typeString(t.Elt, prefix+"[]")
+	case *ast.MapType:
+		return "map[" + types.ExprString(t.Key) + "]" + api.typeString(t.Value, prefix+"[*]")
+	default:
+		return types.ExprString(expr)
+	}
+}
+
+func receiverName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(t.X)
+	case *ast.IndexExpr:
+		return receiverName(t.X)
+	case *ast.IndexListExpr:
+		return receiverName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return fmt.Sprint(expr)
+}
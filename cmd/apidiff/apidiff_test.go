@@ -0,0 +1,243 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const oldSource = `package cloapi
+
+type ClientInterface interface {
+	ServerList(ctx context.Context) (*http.Response, error)
+	ServerDelete(ctx context.Context, id string) (*http.Response, error)
+}
+
+type ServerSchema struct {
+	Id        string ` + "`json:\"id\"`" + `
+	Name      string
+	Addresses []struct {
+		Id string
+	}
+}
+
+type ServerDeleteResponse struct{ Body []byte }
+
+func NewServerDeleteRequest(server, id string) (*http.Request, error) { return nil, nil }
+
+const StatusActive ServerStatus = "ACTIVE"
+
+func helper() {}
+`
+
+func parse(t *testing.T, src string) API {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "gen.go")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	api, err := ParseAPI(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api
+}
+
+func TestParseAPI(t *testing.T) {
+	api := parse(t, oldSource)
+	for key, want := range map[string]string{
+		"type ServerSchema":                 "struct{…}",
+		"field ServerSchema.Id":             "string",
+		"field ServerSchema.Addresses":      "[]struct{…}",
+		"field ServerSchema.Addresses[].Id": "string",
+		"method ClientInterface.ServerList": "func(ctx context.Context) (*http.Response, error)",
+		"func NewServerDeleteRequest":       "func(server, id string) (*http.Request, error)",
+		"const StatusActive":                `ServerStatus = "ACTIVE"`,
+	} {
+		if got, ok := api[key]; !ok || got != want {
+			t.Errorf("%s = %q, %v; want %q", key, got, ok, want)
+		}
+	}
+	if _, ok := api["func helper"]; ok {
+		t.Error("unexported func in API")
+	}
+}
+
+func TestDiffAPI(t *testing.T) {
+	old := parse(t, oldSource)
+
+	t.Run("unchanged", func(t *testing.T) {
+		r := DiffAPI(old, parse(t, oldSource))
+		if r.Level() != None || r.Bump() != "patch" {
+			t.Errorf("level %v, bump %s; changes %v", r.Level(), r.Bump(), r.Changes)
+		}
+	})
+
+	t.Run("additive", func(t *testing.T) {
+		src := strings.Replace(oldSource, "\t\tId string\n", "\t\tId string\n\t\tVersion int\n", 1)
+		src = strings.Replace(src, "ServerList(ctx context.Context) (*http.Response, error)\n",
+			"ServerList(ctx context.Context) (*http.Response, error)\n\tServerRescue(ctx context.Context, id string) (*http.Response, error)\n", 1)
+		r := DiffAPI(old, parse(t, src))
+		if r.Level() != Additive || r.Bump() != "minor" {
+			t.Fatalf("level %v, bump %s; changes %v", r.Level(), r.Bump(), r.Changes)
+		}
+		if len(r.AddedOps) != 1 || r.AddedOps[0] != "ServerRescue" {
+			t.Errorf("added ops %v", r.AddedOps)
+		}
+		entry := r.ChangelogEntry("v3.2.0", "2026-10-19")
+		for _, want := range []string{
+			"# Release v3.2.0 (2026-10-19)\n",
+			"* Added operations: `ServerRescue`.\n",
+			"* Added: `field ServerSchema.Addresses[].Version`.\n",
+		} {
+			if !strings.Contains(entry, want) {
+				t.Errorf("entry lacks %q:\n%s", want, entry)
+			}
+		}
+		if strings.Contains(entry, "method ClientInterface.ServerRescue") {
+			t.Errorf("entry lists the symbols of an added operation:\n%s", entry)
+		}
+	})
+
+	t.Run("breaking", func(t *testing.T) {
+		src := strings.Replace(oldSource, "\tName      string\n", "\tName      *string\n", 1)
+		src = strings.Replace(src, "\tServerDelete(ctx context.Context, id string) (*http.Response, error)\n", "", 1)
+		src = strings.Replace(src, "type ServerDeleteResponse struct{ Body []byte }\n", "", 1)
+		src = strings.Replace(src, "func NewServerDeleteRequest(server, id string) (*http.Request, error) { return nil, nil }\n", "", 1)
+		r := DiffAPI(old, parse(t, src))
+		if r.Level() != Breaking || r.Bump() != "refuse" {
+			t.Fatalf("level %v, bump %s; changes %v", r.Level(), r.Bump(), r.Changes)
+		}
+		entry := r.ChangelogEntry("v4.0.0", "2026-10-19")
+		for _, want := range []string{
+			"* Removed operations: `ServerDelete`.\n",
+			"* Changed: `field ServerSchema.Name (string → *string)`.\n",
+		} {
+			if !strings.Contains(entry, want) {
+				t.Errorf("entry lacks %q:\n%s", want, entry)
+			}
+		}
+		if strings.Contains(entry, "NewServerDeleteRequest") || strings.Contains(entry, "ServerDeleteResponse") {
+			t.Errorf("entry lists the symbols of a removed operation:\n%s", entry)
+		}
+	})
+}
+
+func TestNextVersion(t *testing.T) {
+	for _, tc := range []struct{ latest, bump, want string }{
+		{"v3.1.0", "patch", "v3.1.1"},
+		{"v3.1.7", "minor", "v3.2.0"},
+	} {
+		if got, err := NextVersion(tc.latest, tc.bump); err != nil || got != tc.want {
+			t.Errorf("NextVersion(%s, %s) = %s, %v; want %s", tc.latest, tc.bump, got, err, tc.want)
+		}
+	}
+	if _, err := NextVersion("v3.1.0", "refuse"); err == nil {
+		t.Error("refuse has a version")
+	}
+	if _, err := NextVersion("latest", "patch"); err == nil {
+		t.Error("bad tag accepted")
+	}
+}
+
+func spec(t *testing.T, doc string) Spec {
+	t.Helper()
+	var s Spec
+	if err := json.Unmarshal([]byte(doc), &s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+const oldSpec = `{
+  "paths": {
+    "/v2/servers/{object_id}": {"delete": {"operationId": "ServerDelete"}},
+    "/v2/projects/{object_id}/servers": {"post": {"operationId": "ServerCreate",
+      "requestBody": {"content": {"application/json": {"schema": {
+        "type": "object", "required": ["name"],
+        "properties": {"name": {"type": "string"}, "flavor": {"type": "object"}}
+      }}}}}}
+  },
+  "components": {"schemas": {
+    "Status": {"type": "string", "enum": ["ACTIVE", "STOPPED"]},
+    "Server": {"type": "object", "properties": {"id": {"type": "string"}}}
+  }}
+}`
+
+func TestDiffSpec(t *testing.T) {
+	if r := DiffSpec(spec(t, oldSpec), spec(t, oldSpec)); r.Level() != None {
+		t.Errorf("unchanged spec: %v", r.Changes)
+	}
+
+	additive := strings.NewReplacer(
+		`"STOPPED"]`, `"STOPPED", "RESCUE"]`,
+		`{"id": {"type": "string"}}`, `{"id": {"type": "string"}, "gpu": {"type": "integer"}}`,
+		`"/v2/servers/{object_id}": {`, `"/v2/servers/{object_id}/rescue": {"post": {"operationId": "ServerRescue"}}, "/v2/servers/{object_id}": {`,
+	).Replace(oldSpec)
+	r := DiffSpec(spec(t, oldSpec), spec(t, additive))
+	if r.Level() != Additive {
+		t.Errorf("additive spec: %v %v", r.Level(), r.Changes)
+	}
+	if len(r.AddedOps) != 1 || r.AddedOps[0] != "ServerRescue" {
+		t.Errorf("added ops %v", r.AddedOps)
+	}
+
+	for name, doc := range map[string]string{
+		"removed operation":  strings.Replace(oldSpec, `"delete"`, `"get"`, 1),
+		"removed enum value": strings.Replace(oldSpec, `, "STOPPED"`, ``, 1),
+		"changed type":       strings.Replace(oldSpec, `{"id": {"type": "string"}}`, `{"id": {"type": "integer"}}`, 1),
+		"newly required":     strings.Replace(oldSpec, `["name"]`, `["name", "flavor"]`, 1),
+	} {
+		if r := DiffSpec(spec(t, oldSpec), spec(t, doc)); r.Level() != Breaking {
+			t.Errorf("%s: %v %v", name, r.Level(), r.Changes)
+		}
+	}
+}
+
+func TestMergeListsOperationsOnce(t *testing.T) {
+	r := &Report{AddedOps: []string{"ServerRescue"}}
+	r.Merge(&Report{AddedOps: []string{"ServerRescue"}, Changes: []Change{
+		{Level: Additive, Kind: "added", Subject: "spec operation POST /v2/servers/{object_id}/rescue (ServerRescue)"},
+	}})
+	if len(r.AddedOps) != 1 {
+		t.Errorf("added ops %v", r.AddedOps)
+	}
+	if entry := r.ChangelogEntry("v3.2.0", "2026-10-19"); strings.Contains(entry, "spec operation") {
+		t.Errorf("entry lists the spec operation:\n%s", entry)
+	}
+}
+
+func TestRunExitCodes(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, src string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	oldGo := write("old_gen.go", oldSource)
+	breaking := write("breaking_gen.go", strings.Replace(oldSource, "\tName      string\n", "\tName      *string\n", 1))
+	broken := write("broken_gen.go", "package cloapi\n\nfunc {")
+
+	for name, tc := range map[string]struct {
+		newGo, latest string
+		want          int
+	}{
+		"breaking":      {breaking, "v3.1.0", exitBreaking},
+		"unparsable":    {broken, "v3.1.0", exitError},
+		"missing file":  {filepath.Join(dir, "absent.go"), "v3.1.0", exitError},
+		"malformed tag": {oldGo, "3.1", exitError},
+	} {
+		err := run(oldGo, tc.newGo, "", "", tc.latest, "", "2026-10-19")
+		if err == nil {
+			t.Errorf("%s: no error", name)
+			continue
+		}
+		if got := exitCode(err); got != tc.want {
+			t.Errorf("%s: exit %d, want %d (%v)", name, got, tc.want, err)
+		}
+	}
+}
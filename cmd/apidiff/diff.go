@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Level classifies a change, or a whole diff by its most severe change.
+type Level int
+
+const (
+	None Level = iota
+	Additive
+	Breaking
+)
+
+func (l Level) String() string {
+	return [...]string{"none", "additive", "breaking"}[l]
+}
+
+// Change is one difference between the old and the new API.
+type Change struct {
+	Level Level
+	// Kind is "added", "removed" or "changed".
+	Kind string
+	// Subject names what changed, e.g. "field ServerSchema.Status" or
+	// "spec POST /v2/projects/{object_id}/servers".
+	Subject  string
+	Old, New string
+}
+
+// Report is the outcome of comparing two versions of the client.
+type Report struct {
+	// Operations added and removed, by operationId.
+	AddedOps, RemovedOps []string
+	Changes              []Change
+}
+
+// Level is the most severe level among the changes.
+func (r *Report) Level() Level {
+	level := None
+	for _, c := range r.Changes {
+		level = max(level, c.Level)
+	}
+	return level
+}
+
+// operationsInterface lists one method per operation in the generated code.
+const operationsInterface = "ClientInterface"
+
+// DiffAPI compares two exported Go APIs. Removing a symbol or changing its
+// type or signature breaks callers; adding one does not. That includes methods
+// added to the generated interfaces, which callers use but are not expected to
+// implement.
+func DiffAPI(old, new API) *Report {
+	r := &Report{}
+	for _, key := range slices.Sorted(maps.Keys(old)) {
+		n, ok := new[key]
+		switch {
+		case !ok:
+			r.Changes = append(r.Changes, Change{Level: Breaking, Kind: "removed", Subject: key, Old: old[key]})
+		case n != old[key]:
+			r.Changes = append(r.Changes, Change{Level: Breaking, Kind: "changed", Subject: key, Old: old[key], New: n})
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(new)) {
+		if _, ok := old[key]; !ok {
+			r.Changes = append(r.Changes, Change{Level: Additive, Kind: "added", Subject: key, New: new[key]})
+		}
+	}
+
+	prefix := "method " + operationsInterface + "."
+	for _, c := range r.Changes {
+		op, ok := strings.CutPrefix(c.Subject, prefix)
+		if !ok || strings.HasSuffix(op, "WithBody") {
+			continue
+		}
+		switch c.Kind {
+		case "added":
+			r.AddedOps = append(r.AddedOps, op)
+		case "removed":
+			r.RemovedOps = append(r.RemovedOps, op)
+		}
+	}
+	return r
+}
+
+// Merge appends the changes of other to r. Operations both reports list, the Go
+// methods and the spec operations of the same operationId, are listed once.
+func (r *Report) Merge(other *Report) {
+	r.Changes = append(r.Changes, other.Changes...)
+	for _, op := range other.AddedOps {
+		if !slices.Contains(r.AddedOps, op) {
+			r.AddedOps = append(r.AddedOps, op)
+		}
+	}
+	for _, op := range other.RemovedOps {
+		if !slices.Contains(r.RemovedOps, op) {
+			r.RemovedOps = append(r.RemovedOps, op)
+		}
+	}
+}
+
+// Bump is the release decision for a report: "minor" for additions, "patch"
+// when the API is unchanged, "refuse" for breaking changes, which need a new
+// major version and so a new module path.
+func (r *Report) Bump() string {
+	switch r.Level() {
+	case Breaking:
+		return "refuse"
+	case Additive:
+		return "minor"
+	default:
+		return "patch"
+	}
+}
+
+// NextVersion applies bump to latest, a vMAJOR.MINOR.PATCH tag.
+func NextVersion(latest, bump string) (string, error) {
+	var major, minor, patch int
+	if _, err := fmt.Sscanf(latest, "v%d.%d.%d", &major, &minor, &patch); err != nil {
+		return "", fmt.Errorf("version %q: %w", latest, err)
+	}
+	switch bump {
+	case "minor":
+		return fmt.Sprintf("v%d.%d.0", major, minor+1), nil
+	case "patch":
+		return fmt.Sprintf("v%d.%d.%d", major, minor, patch+1), nil
+	}
+	return "", fmt.Errorf("no version for %s", bump)
+}
+
+// ownedByOperation reports whether the symbol key is generated for operation
+// op, so the changelog can list the operation instead of its symbols.
+func ownedByOperation(key, op string) bool {
+	if strings.HasPrefix(key, "spec operation ") {
+		return strings.HasSuffix(key, " ("+op+")")
+	}
+	_, name, _ := strings.Cut(key, " ")
+	if recv, method, ok := strings.Cut(name, "."); ok {
+		switch recv {
+		case "Client", "ClientInterface", "ClientWithResponses", "ClientWithResponsesInterface":
+			return method == op || method == op+"WithBody" || method == op+"WithResponse" || method == op+"WithBodyWithResponse"
+		}
+	}
+	for _, owned := range []string{"New" + op + "Request", "Parse" + op + "Response"} {
+		if name == owned || name == owned+"WithBody" {
+			return true
+		}
+	}
+	// Request bodies, their fields and their enum types and values.
+	if strings.HasPrefix(name, op+"JSONBody") || strings.HasPrefix(name, op+"JSONRequestBody") {
+		return true
+	}
+	for _, owned := range []string{op + "Response", op + "Params"} {
+		if name == owned || strings.HasPrefix(name, owned+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// ChangelogEntry renders r as a CHANGELOG.md release section.
+func (r *Report) ChangelogEntry(version, date string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Release %s (%s)\n", version, date)
+	if r.Level() == None {
+		b.WriteString("* Regenerated client; no API changes.\n")
+		return b.String()
+	}
+	if len(r.AddedOps) > 0 {
+		fmt.Fprintf(&b, "* Added operations: %s.\n", codeList(r.AddedOps))
+	}
+	if len(r.RemovedOps) > 0 {
+		fmt.Fprintf(&b, "* Removed operations: %s.\n", codeList(r.RemovedOps))
+	}
+	ops := append(slices.Clone(r.AddedOps), r.RemovedOps...)
+	groups := map[string][]string{}
+	for _, c := range r.Changes {
+		if slices.ContainsFunc(ops, func(op string) bool { return ownedByOperation(c.Subject, op) }) {
+			continue
+		}
+		item := c.Subject
+		if c.Kind == "changed" {
+			item = fmt.Sprintf("%s (%s → %s)", c.Subject, c.Old, c.New)
+		}
+		groups[c.Kind] = append(groups[c.Kind], item)
+	}
+	for _, kind := range []string{"added", "removed", "changed"} {
+		if items := groups[kind]; len(items) > 0 {
+			fmt.Fprintf(&b, "* %s%s: %s.\n", strings.ToUpper(kind[:1]), kind[1:], codeList(items))
+		}
+	}
+	return b.String()
+}
+
+func codeList(items []string) string {
+	quoted := make([]string, len(items))
+	for i, it := range items {
+		quoted[i] = "`" + it + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,117 @@
+// Command apidiff compares the generated client of the last release with the
+// current one and decides the next version, so releases follow semver:
+//
+//	apidiff -old old_gen.go -new clo_gen.go [-old-spec a.json -new-spec b.json] -latest v3.4.1 [-changelog CHANGELOG.md]
+//
+// It prints the decision on stdout, "minor v3.5.0" when the API only grew,
+// "patch v3.4.2" when it is unchanged, and the list of changes on stderr. With
+// -changelog the release entry is prepended to that file. Breaking changes
+// print "refuse" and exit with status 1 without touching the changelog: they
+// need a new major version, which is a new module path and a human decision.
+// Any other failure, such as a file that does not parse, exits with status 2.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Exit statuses, so that callers can tell a refused release from a broken run.
+const (
+	exitBreaking = 1
+	exitError    = 2
+)
+
+// errBreaking is returned by run when the changes need a new major version.
+var errBreaking = errors.New("breaking API changes; release them as a new major version by hand")
+
+func main() {
+	oldGo := flag.String("old", "", "generated Go file of the latest release")
+	newGo := flag.String("new", "", "generated Go file to release")
+	oldSpec := flag.String("old-spec", "", "OpenAPI spec of the latest release (optional)")
+	newSpec := flag.String("new-spec", "", "OpenAPI spec to release (optional)")
+	latest := flag.String("latest", "", "latest release tag, vMAJOR.MINOR.PATCH")
+	changelog := flag.String("changelog", "", "changelog file to prepend the release entry to")
+	date := flag.String("date", time.Now().UTC().Format(time.DateOnly), "release date")
+	flag.Parse()
+	if *oldGo == "" || *newGo == "" || *latest == "" {
+		flag.Usage()
+		os.Exit(exitError)
+	}
+	if err := run(*oldGo, *newGo, *oldSpec, *newSpec, *latest, *changelog, *date); err != nil {
+		fmt.Fprintln(os.Stderr, "apidiff:", err)
+		os.Exit(exitCode(err))
+	}
+}
+
+// exitCode is the exit status for an error returned by run.
+func exitCode(err error) int {
+	if errors.Is(err, errBreaking) {
+		return exitBreaking
+	}
+	return exitError
+}
+
+func run(oldGo, newGo, oldSpec, newSpec, latest, changelog, date string) error {
+	oldAPI, err := ParseAPI(oldGo)
+	if err != nil {
+		return err
+	}
+	newAPI, err := ParseAPI(newGo)
+	if err != nil {
+		return err
+	}
+	r := DiffAPI(oldAPI, newAPI)
+	if oldSpec != "" && newSpec != "" {
+		o, err := ReadSpec(oldSpec)
+		if err != nil {
+			return err
+		}
+		n, err := ReadSpec(newSpec)
+		if err != nil {
+			return err
+		}
+		r.Merge(DiffSpec(o, n))
+	}
+
+	for _, c := range r.Changes {
+		fmt.Fprintf(os.Stderr, "%-8s %-7s %s", c.Level, c.Kind, c.Subject)
+		if c.Kind == "changed" {
+			fmt.Fprintf(os.Stderr, ": %s → %s", c.Old, c.New)
+		}
+		fmt.Fprintln(os.Stderr)
+	}
+	bump := r.Bump()
+	if bump == "refuse" {
+		fmt.Println(bump)
+		return errBreaking
+	}
+	version, err := NextVersion(latest, bump)
+	if err != nil {
+		return err
+	}
+	if changelog != "" {
+		if err := prependFile(changelog, r.ChangelogEntry(version, date)); err != nil {
+			return err
+		}
+	}
+	fmt.Println(bump, version)
+	return nil
+}
+
+// prependFile writes entry, a blank line and the previous content of path.
+func prependFile(path, entry string) error {
+	old, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	content := entry
+	if len(old) > 0 {
+		content += "\n" + strings.TrimLeft(string(old), "\n")
+	}
+	return os.WriteFile(path, []byte(content), 0o644)
+}
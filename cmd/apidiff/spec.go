@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Spec is a decoded OpenAPI document.
+type Spec map[string]any
+
+// ReadSpec reads the OpenAPI JSON document at path.
+func ReadSpec(path string) (Spec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Spec
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+var httpMethods = []string{"get", "put", "post", "delete", "patch", "head", "options"}
+
+// operations returns the operations of s keyed "METHOD path".
+func (s Spec) operations() map[string]map[string]any {
+	ops := map[string]map[string]any{}
+	for path, item := range object(s["paths"]) {
+		for _, method := range httpMethods {
+			if op := object(object(item)[method]); op != nil {
+				ops[strings.ToUpper(method)+" "+path] = op
+			}
+		}
+	}
+	return ops
+}
+
+// DiffSpec compares two OpenAPI documents: their operations, the JSON request
+// body of every operation in both, and the component schemas. Removals and type
+// changes break callers, as does a request field becoming required; additions
+// do not.
+func DiffSpec(old, new Spec) *Report {
+	d := &specDiff{r: &Report{}}
+	oldOps, newOps := old.operations(), new.operations()
+	for _, key := range slices.Sorted(maps.Keys(oldOps)) {
+		id, _ := oldOps[key]["operationId"].(string)
+		n, ok := newOps[key]
+		if !ok {
+			d.r.RemovedOps = append(d.r.RemovedOps, id)
+			d.add(Breaking, "removed", fmt.Sprintf("spec operation %s (%s)", key, id), "", "")
+			continue
+		}
+		d.schema("spec request "+key, requestSchema(oldOps[key]), requestSchema(n), true)
+	}
+	for _, key := range slices.Sorted(maps.Keys(newOps)) {
+		if _, ok := oldOps[key]; !ok {
+			id, _ := newOps[key]["operationId"].(string)
+			d.r.AddedOps = append(d.r.AddedOps, id)
+			d.add(Additive, "added", fmt.Sprintf("spec operation %s (%s)", key, id), "", "")
+		}
+	}
+
+	oldSchemas := object(object(old["components"])["schemas"])
+	newSchemas := object(object(new["components"])["schemas"])
+	for _, name := range slices.Sorted(maps.Keys(oldSchemas)) {
+		n, ok := newSchemas[name]
+		if !ok {
+			d.add(Breaking, "removed", "spec schema "+name, "", "")
+			continue
+		}
+		d.schema("spec schema "+name, object(oldSchemas[name]), object(n), false)
+	}
+	for _, name := range slices.Sorted(maps.Keys(newSchemas)) {
+		if _, ok := oldSchemas[name]; !ok {
+			d.add(Additive, "added", "spec schema "+name, "", "")
+		}
+	}
+	return d.r
+}
+
+type specDiff struct {
+	r *Report
+}
+
+func (d *specDiff) add(level Level, kind, subject, old, new string) {
+	d.r.Changes = append(d.r.Changes, Change{Level: level, Kind: kind, Subject: subject, Old: old, New: new})
+}
+
+// schema compares two schemas at subject. request marks request bodies, where
+// newly required fields break callers.
+func (d *specDiff) schema(subject string, old, new map[string]any, request bool) {
+	if old == nil || new == nil {
+		switch {
+		case old == nil && new != nil:
+			d.add(Additive, "added", subject, "", "")
+		case old != nil && new == nil:
+			d.add(Breaking, "removed", subject, "", "")
+		}
+		return
+	}
+	if o, n := schemaType(old), schemaType(new); o != n {
+		d.add(Breaking, "changed", subject, o, n)
+		return
+	}
+
+	oldEnum, newEnum := enumValues(old), enumValues(new)
+	for _, v := range oldEnum {
+		if !slices.Contains(newEnum, v) {
+			d.add(Breaking, "removed", subject+" enum "+v, "", "")
+		}
+	}
+	for _, v := range newEnum {
+		if !slices.Contains(oldEnum, v) {
+			d.add(Additive, "added", subject+" enum "+v, "", "")
+		}
+	}
+
+	oldProps, newProps := object(old["properties"]), object(new["properties"])
+	oldReq, newReq := stringList(old["required"]), stringList(new["required"])
+	for _, name := range slices.Sorted(maps.Keys(oldProps)) {
+		n, ok := newProps[name]
+		if !ok {
+			d.add(Breaking, "removed", subject+"."+name, "", "")
+			continue
+		}
+		if request && !slices.Contains(oldReq, name) && slices.Contains(newReq, name) {
+			d.add(Breaking, "changed", subject+"."+name, "optional", "required")
+		}
+		d.schema(subject+"."+name, object(oldProps[name]), object(n), request)
+	}
+	for _, name := range slices.Sorted(maps.Keys(newProps)) {
+		if _, ok := oldProps[name]; ok {
+			continue
+		}
+		level := Additive
+		if request && slices.Contains(newReq, name) {
+			level = Breaking
+		}
+		d.add(level, "added", subject+"."+name, "", "")
+	}
+
+	if items := object(old["items"]); items != nil {
+		d.schema(subject+"[]", items, object(new["items"]), request)
+	}
+}
+
+// requestSchema returns the JSON request body schema of op, or nil.
+func requestSchema(op map[string]any) map[string]any {
+	content := object(object(op["requestBody"])["content"])
+	return object(object(content["application/json"])["schema"])
+}
+
+// schemaType renders what a schema is at its top level: a reference, or its
+// type and format.
+func schemaType(s map[string]any) string {
+	if ref, ok := s["$ref"].(string); ok {
+		return ref
+	}
+	t := fmt.Sprint(s["type"])
+	if s["type"] == nil {
+		t = "any"
+	}
+	if f, ok := s["format"].(string); ok {
+		t += "/" + f
+	}
+	return t
+}
+
+func enumValues(s map[string]any) []string {
+	values, _ := s["enum"].([]any)
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = fmt.Sprint(v)
+	}
+	return out
+}
+
+func stringList(v any) []string {
+	items, _ := v.([]any)
+	out := make([]string, 0, len(items))
+	for _, it := range items {
+		if s, ok := it.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func object(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
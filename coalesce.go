@@ -0,0 +1,138 @@
+package cloapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// coalesceRoundTripper merges identical concurrent GETs into one upstream call.
+// The first caller's request goes out; callers arriving while it is in flight
+// wait for it and each get their own copy of the response.
+type coalesceRoundTripper struct {
+	Proxied http.RoundTripper
+
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is one shared upstream call and the callers waiting for it.
+type flight struct {
+	done    chan struct{}
+	res     *sharedResponse
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// sharedResponse is a fully read response that waiters copy from.
+type sharedResponse struct {
+	resp *http.Response
+	body []byte
+}
+
+func (c *coalesceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return c.Proxied.RoundTrip(req)
+	}
+
+	key := coalesceKey(req)
+	c.mu.Lock()
+	f := c.flights[key]
+	if f == nil {
+		// Detached from the first caller, whose cancellation must not fail the
+		// others; the flight is cancelled once its last waiter gives up, so it
+		// lives as long as the longest waiter's deadline.
+		ctx, cancel := context.WithCancel(context.WithoutCancel(req.Context()))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		if c.flights == nil {
+			c.flights = map[string]*flight{}
+		}
+		c.flights[key] = f
+		go c.fly(key, f, req.Clone(ctx))
+	}
+	f.waiters++
+	c.mu.Unlock()
+
+	select {
+	case <-f.done:
+		if f.err != nil {
+			return nil, f.err
+		}
+		resp := new(http.Response)
+		*resp = *f.res.resp
+		resp.Header = f.res.resp.Header.Clone()
+		resp.Body = io.NopCloser(bytes.NewReader(f.res.body))
+		resp.Request = req
+		return resp, nil
+	case <-req.Context().Done():
+		c.mu.Lock()
+		if f.waiters--; f.waiters == 0 {
+			c.land(key, f)
+		}
+		c.mu.Unlock()
+		return nil, req.Context().Err()
+	}
+}
+
+// fly runs the shared call of f and releases its waiters.
+func (c *coalesceRoundTripper) fly(key string, f *flight, req *http.Request) {
+	f.res, f.err = func() (*sharedResponse, error) {
+		resp, err := c.Proxied.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &sharedResponse{resp: resp, body: body}, nil
+	}()
+	c.mu.Lock()
+	c.land(key, f)
+	c.mu.Unlock()
+	close(f.done)
+}
+
+// land cancels f and stops new callers from joining it. c.mu must be held.
+func (c *coalesceRoundTripper) land(key string, f *flight) {
+	f.cancel()
+	if c.flights[key] == f {
+		delete(c.flights, key)
+	}
+}
+
+// coalesceKey identifies requests that may share a response: same URL, same
+// headers and same call options. Credentials come from the client's one token
+// source, so requests of one client differ in auth only if an editor sets the
+// header itself, and then the header is part of the key.
+func coalesceKey(req *http.Request) string {
+	var b strings.Builder
+	b.WriteString(req.URL.String())
+	b.WriteByte('\n')
+	_ = req.Header.Write(&b)
+	callOptionsFrom(req.Context()).writeKey(&b)
+	return b.String()
+}
+
+// writeKey writes the options that change how a request is sent or its response
+// read, so calls that set them differently do not share a response.
+func (o callOptions) writeKey(b *strings.Builder) {
+	if o.timeout != nil {
+		fmt.Fprintf(b, "timeout=%v\n", *o.timeout)
+	}
+	if o.retry != nil {
+		fmt.Fprintf(b, "retry=%d/%v\n", o.retry.Count, o.retry.Backoff)
+	}
+	if o.logLevel != nil {
+		fmt.Fprintf(b, "log=%v\n", *o.logLevel)
+	}
+	if o.rawBody != nil {
+		fmt.Fprintf(b, "raw=%t\n", *o.rawBody)
+	}
+}
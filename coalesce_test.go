@@ -0,0 +1,145 @@
+package cloapi
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestCoalescing(t *testing.T) {
+	var hits int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count":1,"result":[{"id":"img-1","name":"ubuntu"}]}`))
+	}))
+	defer srv.Close()
+
+	cli, err := New("tok", WithBaseURL(srv.URL), WithRequestCoalescing())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const callers = 10
+	var wg sync.WaitGroup
+	names := make([]string, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := cli.ProjectImagesListWithResponse(context.Background(), "p1")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			names[i] = (*resp.OK.Result)[0].Name
+		}()
+	}
+	time.Sleep(50 * time.Millisecond) // let every caller join the flight
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("upstream hits = %d, want 1", got)
+	}
+	for i, n := range names {
+		if n != "ubuntu" {
+			t.Errorf("caller %d got %q", i, n)
+		}
+	}
+
+	// Sequential calls are not cached.
+	if _, err := cli.ProjectImagesListWithResponse(context.Background(), "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&hits); got != 2 {
+		t.Errorf("upstream hits = %d, want 2", got)
+	}
+}
+
+func TestCoalescingWaiterCancellation(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	cli, err := New("tok", WithBaseURL(srv.URL), WithRequestCoalescing())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := cli.AccountBalanceWithResponse(ctx); err == nil {
+		t.Fatal("a cancelled caller should stop waiting")
+	}
+}
+
+func TestCoalesceKey(t *testing.T) {
+	a, _ := http.NewRequest(http.MethodGet, "https://api.clo.ru/v2/projects", nil)
+	b := a.Clone(context.Background())
+	if coalesceKey(a) != coalesceKey(b) {
+		t.Fatal("identical requests must share a key")
+	}
+	b.Header.Set("Authorization", "Bearer other")
+	if coalesceKey(a) == coalesceKey(b) {
+		t.Fatal("requests with different credentials must not share a key")
+	}
+
+	ctx := context.Background()
+	for name, opts := range map[string]context.Context{
+		"timeout":  WithCallTimeout(ctx, time.Minute),
+		"retry":    WithoutRetry(ctx),
+		"raw body": WithCallRawBody(ctx, false),
+		"log":      WithCallLogLevel(ctx, slog.LevelDebug),
+	} {
+		if coalesceKey(a) == coalesceKey(a.WithContext(opts)) {
+			t.Errorf("requests with a different %s must not share a key", name)
+		}
+	}
+}
+
+func TestCoalescingAbandonedFlight(t *testing.T) {
+	gone := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		close(gone)
+	}))
+	defer srv.Close()
+
+	// WithHTTPClient leaves no default timeout below to bound the shared call.
+	cli, err := New("tok", WithBaseURL(srv.URL), WithHTTPClient(&http.Client{}), WithRequestCoalescing())
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	var wg sync.WaitGroup
+	for _, d := range []time.Duration{20 * time.Millisecond, 80 * time.Millisecond} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), d)
+			defer cancel()
+			_, _ = cli.AccountBalanceWithResponse(ctx)
+		}()
+	}
+	wg.Wait()
+	select {
+	case <-gone:
+	case <-time.After(time.Second):
+		t.Fatal("shared request outlived its last waiter")
+	}
+	if d := time.Since(start); d < 80*time.Millisecond {
+		t.Errorf("shared request ended after %v, before its last waiter gave up", d)
+	}
+}
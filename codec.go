@@ -0,0 +1,38 @@
+package cloapi
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONCodec encodes request bodies and decodes response bodies for the generated
+// client. The default is encoding/json; SetJSONCodec swaps in another
+// implementation, e.g. a faster library or encoding/json/v2.
+type JSONCodec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+	// Decode reads one JSON value from r into v, returning io.EOF if r holds no
+	// value at all.
+	Decode(r io.Reader, v any) error
+}
+
+// jsonCodec is the codec the generated code calls.
+var jsonCodec JSONCodec = StdJSONCodec{}
+
+// SetJSONCodec makes the generated client use c for every request and response
+// body; nil restores StdJSONCodec. The codec must honour the `json` struct tags
+// and json.Marshaler/json.Unmarshaler of the generated models. Call it once at
+// start-up, before any request is made: the setting is not synchronised.
+func SetJSONCodec(c JSONCodec) {
+	if c == nil {
+		c = StdJSONCodec{}
+	}
+	jsonCodec = c
+}
+
+// StdJSONCodec is the encoding/json codec.
+type StdJSONCodec struct{}
+
+func (StdJSONCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (StdJSONCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+func (StdJSONCodec) Decode(r io.Reader, v any) error    { return json.NewDecoder(r).Decode(v) }
@@ -0,0 +1,233 @@
+package cloapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gojson "github.com/goccy/go-json"
+)
+
+// goJSONCodec is the alternative the benchmarks compare against: goccy/go-json,
+// a drop-in replacement for encoding/json.
+type goJSONCodec struct{}
+
+func (goJSONCodec) Marshal(v any) ([]byte, error)      { return gojson.Marshal(v) }
+func (goJSONCodec) Unmarshal(data []byte, v any) error { return gojson.Unmarshal(data, v) }
+func (goJSONCodec) Decode(r io.Reader, v any) error    { return gojson.NewDecoder(r).Decode(v) }
+
+// countingCodec counts the calls reaching it.
+type countingCodec struct {
+	StdJSONCodec
+	marshal, unmarshal, decode atomic.Int32
+}
+
+func (c *countingCodec) Marshal(v any) ([]byte, error) {
+	c.marshal.Add(1)
+	return c.StdJSONCodec.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v any) error {
+	c.unmarshal.Add(1)
+	return c.StdJSONCodec.Unmarshal(data, v)
+}
+
+func (c *countingCodec) Decode(r io.Reader, v any) error {
+	c.decode.Add(1)
+	return c.StdJSONCodec.Decode(r, v)
+}
+
+func TestSetJSONCodec(t *testing.T) {
+	codec := &countingCodec{}
+	SetJSONCodec(codec)
+	defer SetJSONCodec(nil)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			_, _ = io.WriteString(w, `{"result":{"id":"p1"}}`)
+			return
+		}
+		_, _ = w.Write(serversPayload(2))
+	}))
+	defer srv.Close()
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	name := "web"
+	if _, err := cli.ProjectCreateWithResponse(ctx, ProjectCreateJSONRequestBody{DisplayName: &name}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.ProjectServerListWithResponse(WithCallRawBody(ctx, false), "p1"); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := cli.ProjectServerListWithResponse(ctx, "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*resp.OK.Result) != 2 {
+		t.Fatalf("servers = %d", len(*resp.OK.Result))
+	}
+	if codec.marshal.Load() != 1 || codec.unmarshal.Load() != 2 || codec.decode.Load() != 1 {
+		t.Errorf("marshal/unmarshal/decode = %d/%d/%d, want 1/2/1", codec.marshal.Load(), codec.unmarshal.Load(), codec.decode.Load())
+	}
+}
+
+// TestGoJSONCodecAgrees guards the benchmark: both codecs must decode the
+// payloads to the same values.
+func TestGoJSONCodecAgrees(t *testing.T) {
+	for _, p := range codecPayloads() {
+		t.Run(p.name, func(t *testing.T) {
+			std, alt := p.newValue(), p.newValue()
+			if err := (StdJSONCodec{}).Unmarshal(p.data, std); err != nil {
+				t.Fatal(err)
+			}
+			if err := (goJSONCodec{}).Unmarshal(p.data, alt); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(std, alt) {
+				t.Error("codecs decode differently")
+			}
+		})
+	}
+}
+
+func serversPayload(n int) []byte {
+	servers := make([]ServerSchema, n)
+	created := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	for i := range servers {
+		disk, addr := 20, fmt.Sprintf("10.0.%d.%d", i/250, i%250+1)
+		servers[i] = ServerSchema{
+			Addresses:      &[]string{addr, fmt.Sprintf("185.12.%d.%d", i/250, i%250+1)},
+			AdminKeyStatus: "ACTIVE",
+			CreatedIn:      created.Add(time.Duration(i) * time.Minute),
+			Datacenter:     "msk-1",
+			DiskData:       &[]ServerDiskData{{Id: fmt.Sprintf("4b0d4f5e-%04d-4c0a-9d47-3f1a2b3c4d5e", i), StorageType: "volume"}},
+			Flavor:         &ServerFlavorSchema{CpuType: "shared", Disk: &disk, Ram: 4, Vcpus: 2},
+			Id:             fmt.Sprintf("9a3e2c1d-%04d-4f6b-8a7c-1e2d3c4b5a69", i),
+			Image: &ServerImageSchema{MinDisk: 10, MinRam: 1, OperationSystem: SchemasResponseV2ServerServerImageSchemaSystem{
+				Distribution: "ubuntu", OsFamily: "linux", Version: "22.04",
+			}},
+			Name:           fmt.Sprintf("web-%03d", i),
+			PrimaryAddress: &addr,
+			Project:        "2f1e4c6a-2a7b-4ef4-8a4b-61f0a3c5b0a1",
+			RescueMode:     "DISABLED",
+			Snapshots:      &[]string{},
+			Status:         "ACTIVE",
+			SwitchStatus:   "DONE",
+		}
+	}
+	b, _ := json.Marshal(PagServersSchema{Count: n, Result: &servers})
+	return b
+}
+
+func accountStatPayload(n int) []byte {
+	stats := make([]Stat, n)
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	addons := []string{"cpu", "ram", "disk", "traffic"}
+	for i := range stats {
+		stats[i] = Stat{
+			Addon:    addons[i%len(addons)],
+			Measure:  "hour",
+			Param:    fmt.Sprintf("server-%d", i%50),
+			Statdate: day.Add(time.Duration(i) * time.Hour),
+			Value:    float32(i%97) / 4,
+		}
+		stats[i].ProjectId[0] = byte(i % 7)
+	}
+	b, _ := json.Marshal(AccountStatSchema{Count: n, Result: stats})
+	return b
+}
+
+type codecPayload struct {
+	name     string
+	data     []byte
+	newValue func() any
+}
+
+func codecPayloads() []codecPayload {
+	return []codecPayload{
+		{"PagServersSchema", serversPayload(500), func() any { return new(PagServersSchema) }},
+		{"AccountStatSchema", accountStatPayload(5000), func() any { return new(AccountStatSchema) }},
+	}
+}
+
+var benchCodecs = []struct {
+	name  string
+	codec JSONCodec
+}{
+	{"encoding_json", StdJSONCodec{}},
+	{"go_json", goJSONCodec{}},
+}
+
+// BenchmarkCodecUnmarshal measures the Parse*Response decoding step on realistic
+// list payloads: go test -bench Codec -benchmem
+func BenchmarkCodecUnmarshal(b *testing.B) {
+	for _, p := range codecPayloads() {
+		for _, c := range benchCodecs {
+			b.Run(p.name+"/"+c.name, func(b *testing.B) {
+				b.SetBytes(int64(len(p.data)))
+				b.ReportAllocs()
+				for b.Loop() {
+					if err := c.codec.Unmarshal(p.data, p.newValue()); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkCodecMarshal(b *testing.B) {
+	for _, p := range codecPayloads() {
+		v := p.newValue()
+		if err := json.Unmarshal(p.data, v); err != nil {
+			b.Fatal(err)
+		}
+		for _, c := range benchCodecs {
+			b.Run(p.name+"/"+c.name, func(b *testing.B) {
+				b.SetBytes(int64(len(p.data)))
+				b.ReportAllocs()
+				for b.Loop() {
+					if _, err := c.codec.Marshal(v); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkCodecParse runs a whole generated Parse*Response, as a client would.
+func BenchmarkCodecParse(b *testing.B) {
+	data := serversPayload(500)
+	for _, c := range benchCodecs {
+		b.Run("PagServersSchema/"+c.name, func(b *testing.B) {
+			SetJSONCodec(c.codec)
+			defer SetJSONCodec(nil)
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			for b.Loop() {
+				rsp := &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": {"application/json"}},
+					Body:       io.NopCloser(bytes.NewReader(data)),
+				}
+				if _, err := ParseProjectServerListResponse(rsp); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
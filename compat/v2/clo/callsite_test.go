@@ -0,0 +1,112 @@
+package clo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+	clo_tools "github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo/request_tools"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/services/addresses"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/services/projects"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/services/servers"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/services/snapshots"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/services/volumes"
+)
+
+// v2CallSite is v2 code as it was written against
+// github.com/clo-ru/cloapi-go-client/v2, with only its imports switched. It
+// must keep compiling unchanged.
+func v2CallSite(ctx context.Context, cli *clo.ApiClient, projectID string) ([]string, error) {
+	var log []string
+
+	createReq := servers.ServerCreateRequest{
+		ProjectID: projectID,
+		Body: servers.ServerCreateBody{
+			Name:   "my_server",
+			Image:  "img",
+			Flavor: servers.ServerFlavorBody{Ram: 2048, Vcpus: 2},
+		},
+	}
+	createReq.WithRetry(2, 0)
+	created, err := createReq.Do(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+	serverID := created.Result.ID
+
+	detail, err := (&servers.ServerDetailRequest{ServerID: serverID}).Do(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+	log = append(log, fmt.Sprintf("%s %s %d", detail.Result.Name, detail.Result.Status, detail.Result.Flavor.Ram))
+
+	stopReq := servers.ServerStopRequest{ServerID: serverID}
+	stopped, err := stopReq.Do(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+	log = append(log, fmt.Sprintf("stop %d", stopped.Code))
+
+	listReq := &volumes.VolumeListRequest{ProjectID: projectID}
+	clo.AddFilterToRequest(listReq, clo.FilteringField{Field: "name", Condition: "in", Value: "data"})
+	pager, err := clo.NewPaginator(cli, listReq, 50, 0)
+	if err != nil {
+		return nil, err
+	}
+	for !pager.LastPage() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range page.Result {
+			if _, err := (&volumes.VolumeAttachRequest{VolumeID: v.ID, Body: volumes.VolumeAttachBody{ServerID: serverID}}).Do(ctx, cli); err != nil {
+				return nil, err
+			}
+			log = append(log, "attach "+v.ID)
+		}
+	}
+
+	if _, err := (&addresses.AddressDetachRequest{AddressID: "a1"}).Do(ctx, cli); err != nil {
+		return nil, err
+	}
+	if _, err := (&snapshots.SnapshotDeleteRequest{SnapshotID: "sn1"}).Do(ctx, cli); err != nil {
+		return nil, err
+	}
+
+	_, err = (&projects.ProjectDetailRequest{ProjectID: "gone"}).Do(ctx, cli)
+	apiErr := clo_tools.DefaultError{}
+	if errors.As(err, &apiErr) && apiErr.Code == 404 {
+		log = append(log, "project gone")
+	}
+	return log, nil
+}
+
+func TestV2CallSite(t *testing.T) {
+	cli := apiServer(t, func(r *http.Request) (int, string) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/projects/p1/servers":
+			return http.StatusOK, `{"result":{"id":"s1"}}`
+		case r.URL.Path == "/v2/servers/s1/detail":
+			return http.StatusOK, `{"result":{"id":"s1","name":"my_server","status":"ACTIVE","flavor":{"ram":2048,"vcpus":2}}}`
+		case r.URL.Path == "/v2/projects/p1/volumes":
+			return http.StatusOK, `{"count":1,"result":[{"id":"v1","name":"data"}]}`
+		case r.URL.Path == "/v2/projects/gone/detail":
+			return http.StatusNotFound, `{"code":404,"message":"not found"}`
+		case strings.HasSuffix(r.URL.Path, "/stop"):
+			return http.StatusAccepted, ``
+		}
+		return http.StatusOK, `{}`
+	})
+	got, err := v2CallSite(context.Background(), cli, "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"my_server ACTIVE 2048", "stop 202", "attach v1", "project gone"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("log = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,42 @@
+// Package clo is the entry point of the v2 compatibility adapter: the v2
+// ApiClient, request settings and Paginator, implemented over the v3 client.
+// Together with the packages under compat/v2/services it lets v2 code switch its
+// imports from github.com/clo-ru/cloapi-go-client/v2 to
+// github.com/clo-ru/cloapi-go-client/v3/compat/v2 first and move to the v3 API
+// call by call, following MIGRATION.md.
+//
+// Requests keep their v2 names, fields, bodies and Do signatures and are sent
+// through the generated v3 client, so they get its transports and the
+// daily-updated endpoints. The v3 results are mapped onto the v2 result types,
+// so v2 call sites such as resp.Result.ID compile unchanged.
+package clo
+
+import (
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+)
+
+// ApiClient is the v2 client, a handle on a v3 *cloapi.ClientWithResponses.
+type ApiClient struct {
+	cli *cloapi.ClientWithResponses
+}
+
+// NewDefaultClient builds a v3 client for the API at baseURL authenticated with
+// authKey, as cloapi.New(authKey, cloapi.WithBaseURL(baseURL)) does.
+func NewDefaultClient(authKey, baseURL string) (*ApiClient, error) {
+	cli, err := cloapi.New(authKey, cloapi.WithBaseURL(baseURL))
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cli), nil
+}
+
+// NewClient wraps an existing v3 client, so v2-style and v3 calls share its
+// configuration while code migrates.
+func NewClient(cli *cloapi.ClientWithResponses) *ApiClient {
+	return &ApiClient{cli: cli}
+}
+
+// V3 returns the v3 client behind c.
+func (c *ApiClient) V3() *cloapi.ClientWithResponses {
+	return c.cli
+}
@@ -0,0 +1,67 @@
+package clo
+
+import (
+	"context"
+	"errors"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+)
+
+// PaginatedRequest is a v2 list request: the List requests of the services
+// packages.
+type PaginatedRequest[T any] interface {
+	Filterable
+	Do(ctx context.Context, cli *ApiClient) (*ListResponse[T], error)
+	setPage(limit, offset int)
+}
+
+// Paginator walks a v2 list request page by page, on top of cloapi.Paginator:
+//
+//	p, err := clo.NewPaginator(cli, &servers.ServerListRequest{ProjectID: id}, 50, 0)
+//	for !p.LastPage() {
+//		page, err := p.NextPage(ctx)
+//		...
+//	}
+type Paginator[T any] struct {
+	p     *cloapi.Paginator[T]
+	count int
+}
+
+// NewPaginator pages through req, limit items per page, starting at offset. It
+// sets the request's limit and offset on every call; the request's other
+// settings, filters and ordering included, apply to each page.
+func NewPaginator[T any](cli *ApiClient, req PaginatedRequest[T], limit, offset int) (*Paginator[T], error) {
+	if limit <= 0 {
+		return nil, errors.New("clo: paginator limit must be positive")
+	}
+	if offset < 0 {
+		return nil, errors.New("clo: paginator offset must not be negative")
+	}
+	pg := &Paginator[T]{}
+	pg.p = cloapi.NewPaginator(limit, func(ctx context.Context, limit, skip int) ([]T, int, error) {
+		req.setPage(limit, offset+skip)
+		rsp, err := req.Do(ctx, cli)
+		if err != nil {
+			return nil, 0, err
+		}
+		pg.count = rsp.Count
+		// Leave out the items before offset so the walk ends at the API's total.
+		return rsp.Result, rsp.Count - offset, nil
+	})
+	return pg, nil
+}
+
+// NextPage fetches the next page. Its Count is the API's total, whatever the
+// starting offset. It fails once LastPage is true.
+func (p *Paginator[T]) NextPage(ctx context.Context) (*ListResponse[T], error) {
+	items, err := p.p.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ListResponse[T]{Count: p.count, Result: items}, nil
+}
+
+// LastPage reports whether the last page has been fetched.
+func (p *Paginator[T]) LastPage() bool {
+	return !p.p.HasNext()
+}
@@ -0,0 +1,72 @@
+package clo_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/services/servers"
+)
+
+// serverPages serves total servers s0, s1, ... honouring limit and offset.
+func serverPages(t *testing.T, total int) (*clo.ApiClient, *[]string) {
+	var queries []string
+	cli := apiServer(t, func(r *http.Request) (int, string) {
+		queries = append(queries, r.URL.RawQuery)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var items []string
+		for i := offset; i < min(offset+limit, total); i++ {
+			items = append(items, fmt.Sprintf(`{"id":"s%d"}`, i))
+		}
+		return http.StatusOK, fmt.Sprintf(`{"count":%d,"result":[%s]}`, total, strings.Join(items, ","))
+	})
+	return cli, &queries
+}
+
+func TestPaginator(t *testing.T) {
+	cli, queries := serverPages(t, 25)
+	req := &servers.ServerListRequest{ProjectID: "p1"}
+	req.OrderBy("name")
+	p, err := clo.NewPaginator(cli, req, 10, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for !p.LastPage() {
+		page, err := p.NextPage(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.Count != 25 {
+			t.Errorf("Count = %d", page.Count)
+		}
+		for _, s := range page.Result {
+			ids = append(ids, s.ID)
+		}
+	}
+	if len(ids) != 20 || ids[0] != "s5" || ids[19] != "s24" {
+		t.Errorf("ids = %v", ids)
+	}
+	want := []string{"limit=10&offset=5&ordering=name", "limit=10&offset=15&ordering=name"}
+	if fmt.Sprint(*queries) != fmt.Sprint(want) {
+		t.Errorf("queries = %v, want %v", *queries, want)
+	}
+	if _, err := p.NextPage(context.Background()); err == nil {
+		t.Error("NextPage after the last page succeeded")
+	}
+}
+
+func TestPaginatorArguments(t *testing.T) {
+	cli, _ := serverPages(t, 0)
+	for _, tc := range []struct{ limit, offset int }{{0, 0}, {10, -1}} {
+		if _, err := clo.NewPaginator(cli, &servers.ServerListRequest{}, tc.limit, tc.offset); err == nil {
+			t.Errorf("NewPaginator(%d, %d) succeeded", tc.limit, tc.offset)
+		}
+	}
+}
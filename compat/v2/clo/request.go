@@ -0,0 +1,169 @@
+package clo
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo/request_tools"
+)
+
+// Request holds the per-request settings of v2, embedded in every request type
+// of the services packages. The zero value sends the request as the client is
+// configured.
+type Request struct {
+	editors []cloapi.RequestEditorFn
+	retry   *cloapi.RetryConfig
+	logger  *slog.Logger
+	page    *[2]int
+}
+
+// WithRetry retries the request count times, spaced by backoff, in place of the
+// client's retry setting. As in v3, only idempotent methods are retried.
+func (r *Request) WithRetry(count int, backoff time.Duration) {
+	r.retry = &cloapi.RetryConfig{Count: count, Backoff: backoff}
+}
+
+// WithLog logs the outcome of the request to logger, in addition to the client's
+// logging transport.
+func (r *Request) WithLog(logger *slog.Logger) {
+	r.logger = logger
+}
+
+// WithQueryParams adds query parameters to the request.
+func (r *Request) WithQueryParams(params map[string]string) {
+	r.editors = append(r.editors, cloapi.WithQuery(params))
+}
+
+// WithHeaders sets headers on the request.
+func (r *Request) WithHeaders(headers map[string]string) {
+	r.editors = append(r.editors, func(_ context.Context, req *http.Request) error {
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		return nil
+	})
+}
+
+// OrderBy orders a listing by field, "-field" for descending order.
+func (r *Request) OrderBy(field string) {
+	r.editors = append(r.editors, cloapi.WithOrderBy(field))
+}
+
+func (r *Request) request() *Request {
+	return r
+}
+
+func (r *Request) setPage(limit, offset int) {
+	r.page = &[2]int{limit, offset}
+}
+
+// Filterable is a request that takes filters: any request type of the services
+// packages.
+type Filterable interface {
+	request() *Request
+}
+
+// FilteringField is a v2 field filter, `field__condition=value` in the query.
+type FilteringField = cloapi.FilterField
+
+// AddFilterToRequest adds filters to req. As in v3, filters with a condition the
+// API does not support are skipped.
+func AddFilterToRequest(req Filterable, filters ...FilteringField) {
+	r := req.request()
+	r.editors = append(r.editors, cloapi.WithFilter(filters...))
+}
+
+// Response is the result of a v2 detail or create request.
+type Response[T any] struct {
+	Result T
+}
+
+// ListResponse is the result of a v2 list request: one page of Result out of
+// Count items.
+type ListResponse[T any] struct {
+	Count  int
+	Result []T
+}
+
+// ResponseCreated is the result of a v2 create request: the new object's ID.
+type ResponseCreated struct {
+	ID string `json:"id"`
+}
+
+// ActionResponse is the result of a v2 action request (start, stop, attach,
+// delete, ...): the HTTP status the API answered with.
+type ActionResponse struct {
+	Code int
+}
+
+// Result maps result, the result of a generated envelope, onto the v2 type T
+// through their common JSON form. T is the zero value if the API sent none.
+func Result[T any](result any) (*Response[T], error) {
+	v, err := Convert[T](result)
+	if err != nil {
+		return nil, err
+	}
+	return &Response[T]{Result: v}, nil
+}
+
+// List maps the items of a generated list envelope onto the v2 type T.
+func List[T any](count int, result any) (*ListResponse[T], error) {
+	items, err := Convert[[]T](result)
+	if err != nil {
+		return nil, err
+	}
+	return &ListResponse[T]{Count: count, Result: items}, nil
+}
+
+// Do runs call, a generated v3 call, under the settings of r and returns an API
+// error as the request_tools.DefaultError v2 returned. The services packages
+// implement each request's Do with it; operation names the call in logs.
+func Do[R any](ctx context.Context, r *Request, operation string, call func(ctx context.Context, editors ...cloapi.RequestEditorFn) (R, error)) (R, error) {
+	if r.retry != nil {
+		ctx = cloapi.WithCallRetry(ctx, r.retry.Count, r.retry.Backoff)
+	}
+	editors := r.editors
+	if r.page != nil {
+		editors = append(editors[:len(editors):len(editors)], cloapi.WithPage(r.page[0], r.page[1]))
+	}
+
+	start := time.Now()
+	rsp, err := call(ctx, editors...)
+	if r.logger != nil {
+		if err != nil {
+			r.logger.ErrorContext(ctx, "clo request failed", "operation", operation, "duration", time.Since(start), "error", err)
+		} else {
+			r.logger.InfoContext(ctx, "clo request", "operation", operation, "duration", time.Since(start))
+		}
+	}
+	if apiErr, ok := cloapi.AsApiError(err); ok {
+		err = request_tools.FromApiError(apiErr)
+	}
+	return rsp, err
+}
+
+// Action runs call like Do and returns the ActionResponse v2 action requests
+// returned.
+func Action[R interface{ StatusCode() int }](ctx context.Context, r *Request, operation string, call func(ctx context.Context, editors ...cloapi.RequestEditorFn) (R, error)) (*ActionResponse, error) {
+	rsp, err := Do(ctx, r, operation, call)
+	if err != nil {
+		return nil, err
+	}
+	return &ActionResponse{Code: rsp.StatusCode()}, nil
+}
+
+// Convert turns a v2 request body into the generated v3 body, or a v3 result
+// into the v2 one, through their common JSON form.
+func Convert[B any](body any) (B, error) {
+	var out B
+	data, err := json.Marshal(body)
+	if err != nil {
+		return out, err
+	}
+	err = json.Unmarshal(data, &out)
+	return out, err
+}
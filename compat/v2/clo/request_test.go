@@ -0,0 +1,120 @@
+package clo_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+	clo_tools "github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo/request_tools"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/services/servers"
+)
+
+// apiServer serves reply with status to every request, passing each request to
+// seen first.
+func apiServer(t *testing.T, seen func(r *http.Request) (status int, reply string)) *clo.ApiClient {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, reply := seen(r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, reply)
+	}))
+	t.Cleanup(srv.Close)
+	cli, err := clo.NewDefaultClient("tok", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func TestRequestSettings(t *testing.T) {
+	var got *http.Request
+	cli := apiServer(t, func(r *http.Request) (int, string) {
+		got = r
+		return http.StatusOK, `{"count":1,"result":[{"id":"s1","name":"web"}]}`
+	})
+
+	req := servers.ServerListRequest{ProjectID: "p1"}
+	req.WithQueryParams(map[string]string{"name": "web"})
+	req.WithHeaders(map[string]string{"X-Trace": "t1"})
+	req.OrderBy("-created_in")
+	clo.AddFilterToRequest(&req, clo.FilteringField{Field: "created_in", Condition: "gte", Value: "2024-01-01"})
+	rsp, err := req.Do(context.Background(), cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Count != 1 || len(rsp.Result) != 1 || rsp.Result[0].ID != "s1" {
+		t.Errorf("response = %+v", rsp)
+	}
+	if got.URL.Path != "/v2/projects/p1/servers" || got.Header.Get("Authorization") != "Bearer tok" || got.Header.Get("X-Trace") != "t1" {
+		t.Errorf("request = %s %s", got.URL, got.Header)
+	}
+	q := got.URL.Query()
+	if q.Get("name") != "web" || q.Get("ordering") != "-created_in" || q.Get("created_in__gte") != "2024-01-01" {
+		t.Errorf("query = %s", got.URL.RawQuery)
+	}
+}
+
+func TestRequestError(t *testing.T) {
+	cli := apiServer(t, func(*http.Request) (int, string) {
+		return http.StatusNotFound, `{"code":404,"message":"not found","description":"no such server"}`
+	})
+	req := servers.ServerDetailRequest{ServerID: "s1"}
+	_, err := req.Do(context.Background(), cli)
+
+	apiErr := clo_tools.DefaultError{}
+	if !errors.As(err, &apiErr) || apiErr.Code != 404 || apiErr.Description != "no such server" {
+		t.Fatalf("err = %#v", err)
+	}
+	if !cloapi.IsNotFound(err) {
+		t.Error("IsNotFound is false")
+	}
+}
+
+func TestRequestRetry(t *testing.T) {
+	calls := 0
+	cli := apiServer(t, func(*http.Request) (int, string) {
+		calls++
+		if calls == 1 {
+			return http.StatusServiceUnavailable, `{"message":"busy"}`
+		}
+		return http.StatusOK, `{"result":{"id":"s1"}}`
+	})
+	req := servers.ServerDetailRequest{ServerID: "s1"}
+	req.WithRetry(2, 0)
+	rsp, err := req.Do(context.Background(), cli)
+	if err != nil || rsp.Result.ID != "s1" || calls != 2 {
+		t.Fatalf("rsp = %+v, err = %v after %d calls", rsp, err, calls)
+	}
+}
+
+func TestRequestLog(t *testing.T) {
+	cli := apiServer(t, func(*http.Request) (int, string) { return http.StatusOK, `{"result":{"id":"s1"}}` })
+	var buf bytes.Buffer
+	req := servers.ServerDetailRequest{ServerID: "s1"}
+	req.WithLog(slog.New(slog.NewTextHandler(&buf, nil)))
+	if _, err := req.Do(context.Background(), cli); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "operation=ServerDetail") {
+		t.Errorf("log = %q", buf.String())
+	}
+}
+
+func TestNewClientSharesV3Client(t *testing.T) {
+	v3, err := cloapi.New("tok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clo.NewClient(v3).V3() != v3 {
+		t.Error("V3 does not return the wrapped client")
+	}
+}
@@ -0,0 +1,44 @@
+// Package request_tools keeps the error type of the v2 clo/request_tools package
+// for code moving to the compat/v2 adapter.
+package request_tools
+
+import (
+	"fmt"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+)
+
+// DefaultError is the error v2 requests returned for a non-2xx response. It
+// unwraps to the v3 *cloapi.ApiError, so both the v2 check
+//
+//	apiErr := clo_tools.DefaultError{}
+//	if errors.As(err, &apiErr) && apiErr.Code == 404 { ... }
+//
+// and cloapi.IsNotFound(err) work on the same error.
+type DefaultError struct {
+	Code        int
+	Message     string
+	Description string
+
+	apiErr *cloapi.ApiError
+}
+
+// FromApiError converts a v3 API error into a DefaultError.
+func FromApiError(err *cloapi.ApiError) DefaultError {
+	return DefaultError{Code: err.Code, Message: err.Message, Description: err.Description, apiErr: err}
+}
+
+func (e DefaultError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("code: %d, message: %s, description: %s", e.Code, e.Message, e.Description)
+	}
+	return fmt.Sprintf("code: %d, message: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the v3 error the DefaultError was converted from.
+func (e DefaultError) Unwrap() error {
+	if e.apiErr == nil {
+		return nil
+	}
+	return e.apiErr
+}
@@ -0,0 +1,31 @@
+package request_tools
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+)
+
+func TestDefaultError(t *testing.T) {
+	apiErr := &cloapi.ApiError{Code: 409, Message: "conflict", Description: "server is busy"}
+	err := fmt.Errorf("create: %w", FromApiError(apiErr))
+
+	got := DefaultError{}
+	if !errors.As(err, &got) || got.Code != 409 || got.Message != "conflict" {
+		t.Fatalf("errors.As = %#v", got)
+	}
+	if got.Error() != "code: 409, message: conflict, description: server is busy" {
+		t.Errorf("Error() = %q", got.Error())
+	}
+	if a, ok := cloapi.AsApiError(err); !ok || a != apiErr {
+		t.Error("does not unwrap to the ApiError")
+	}
+	if (DefaultError{Code: 500, Message: "boom"}).Error() != "code: 500, message: boom" {
+		t.Error("Error() without description")
+	}
+	if (DefaultError{}).Unwrap() != nil {
+		t.Error("zero DefaultError unwraps")
+	}
+}
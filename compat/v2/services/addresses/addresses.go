@@ -0,0 +1,162 @@
+// Package addresses keeps the v2 IP address requests, sent through the v3
+// client. See package clo for how the adapter maps v2 onto v3.
+package addresses
+
+import (
+	"context"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+)
+
+type (
+	AddressCreateResponse = clo.Response[clo.ResponseCreated]
+	AddressDetailResponse = clo.Response[Address]
+	AddressListResponse   = clo.ListResponse[Address]
+	AddressDeleteResponse = clo.ActionResponse
+	AddressAttachResponse = clo.ActionResponse
+	AddressDetachResponse = clo.ActionResponse
+)
+
+// Address is an IP address as v2 returned it. Times are RFC 3339 strings.
+type Address struct {
+	ID               string            `json:"id"`
+	Address          string            `json:"address"`
+	Type             string            `json:"type"`
+	Status           string            `json:"status"`
+	Version          int               `json:"version"`
+	External         bool              `json:"external"`
+	IsPrimary        bool              `json:"is_primary"`
+	DdosProtection   bool              `json:"ddos_protection"`
+	BandwidthMaxMbps int               `json:"bandwidth_max_mbps"`
+	Gateway          string            `json:"gateway"`
+	Mask             string            `json:"mask"`
+	MacAddr          string            `json:"mac_addr"`
+	Ptr              string            `json:"ptr"`
+	AttachedTo       AddressAttachment `json:"attached_to"`
+	CreatedIn        string            `json:"created_in"`
+	UpdatedIn        string            `json:"updated_in"`
+}
+
+// AddressAttachment is the entity an address is attached to, empty if none.
+type AddressAttachment struct {
+	ID     string `json:"id"`
+	Entity string `json:"entity"`
+}
+
+// AddressCreateBody is the body of AddressCreateRequest.
+type AddressCreateBody struct {
+	DdosProtection bool `json:"ddos_protection,omitempty"`
+}
+
+// AddressCreateRequest allocates an address in a project.
+type AddressCreateRequest struct {
+	clo.Request
+	ProjectID string
+	Body      AddressCreateBody
+}
+
+func (r *AddressCreateRequest) Do(ctx context.Context, cli *clo.ApiClient) (*AddressCreateResponse, error) {
+	body, err := clo.Convert[cloapi.AddressCreateJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := clo.Do(ctx, &r.Request, "AddressCreate", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.AddressCreateResponse, error) {
+		return cli.V3().AddressCreateWithResponse(ctx, r.ProjectID, body, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &AddressCreateResponse{}, nil
+	}
+	return clo.Result[clo.ResponseCreated](rsp.OK.Result)
+}
+
+// AddressDetailRequest reads an address.
+type AddressDetailRequest struct {
+	clo.Request
+	AddressID string
+}
+
+func (r *AddressDetailRequest) Do(ctx context.Context, cli *clo.ApiClient) (*AddressDetailResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "AddressDetail", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.AddressDetailResponse, error) {
+		return cli.V3().AddressDetailWithResponse(ctx, r.AddressID, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &AddressDetailResponse{}, nil
+	}
+	return clo.Result[Address](rsp.OK.Result)
+}
+
+// AddressListRequest lists the addresses of a project, one page at a time; see
+// clo.NewPaginator.
+type AddressListRequest struct {
+	clo.Request
+	ProjectID string
+}
+
+func (r *AddressListRequest) Do(ctx context.Context, cli *clo.ApiClient) (*AddressListResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "ProjectAddressesList", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ProjectAddressesListResponse, error) {
+		return cli.V3().ProjectAddressesListWithResponse(ctx, r.ProjectID, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &AddressListResponse{}, nil
+	}
+	return clo.List[Address](rsp.OK.Count, rsp.OK.Result)
+}
+
+// AddressDeleteRequest releases an address.
+type AddressDeleteRequest struct {
+	clo.Request
+	AddressID string
+}
+
+func (r *AddressDeleteRequest) Do(ctx context.Context, cli *clo.ApiClient) (*AddressDeleteResponse, error) {
+	return clo.Action(ctx, &r.Request, "AddressDelete", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.AddressDeleteResponse, error) {
+		return cli.V3().AddressDeleteWithResponse(ctx, r.AddressID, editors...)
+	})
+}
+
+// AddressAttachBody is the body of AddressAttachRequest: the kind of entity the
+// address is attached to, e.g. "server", and its ID.
+type AddressAttachBody struct {
+	Entity           string `json:"entity"`
+	ID               string `json:"id"`
+	BandwidthMaxMbps int    `json:"bandwidth_max_mbps,omitempty"`
+}
+
+// AddressAttachRequest attaches an address to an entity.
+type AddressAttachRequest struct {
+	clo.Request
+	AddressID string
+	Body      AddressAttachBody
+}
+
+func (r *AddressAttachRequest) Do(ctx context.Context, cli *clo.ApiClient) (*AddressAttachResponse, error) {
+	body, err := clo.Convert[cloapi.AddressAttachJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return clo.Action(ctx, &r.Request, "AddressAttach", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.AddressAttachResponse, error) {
+		return cli.V3().AddressAttachWithResponse(ctx, r.AddressID, body, editors...)
+	})
+}
+
+// AddressDetachRequest detaches an address from its entity.
+type AddressDetachRequest struct {
+	clo.Request
+	AddressID string
+}
+
+func (r *AddressDetachRequest) Do(ctx context.Context, cli *clo.ApiClient) (*AddressDetachResponse, error) {
+	return clo.Action(ctx, &r.Request, "AddressDetach", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.AddressDetachResponse, error) {
+		return cli.V3().AddressDetachWithResponse(ctx, r.AddressID, editors...)
+	})
+}
@@ -0,0 +1,89 @@
+package addresses
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+)
+
+type call struct {
+	method, path, body string
+}
+
+func apiServer(t *testing.T, reply string) (*clo.ApiClient, *call) {
+	t.Helper()
+	got := &call{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		*got = call{r.Method, r.URL.Path, string(b)}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, reply)
+	}))
+	t.Cleanup(srv.Close)
+	cli, err := clo.NewDefaultClient("tok", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli, got
+}
+
+func TestAddressRequests(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		do   func(context.Context, *clo.ApiClient) error
+		want call
+	}{
+		{"create", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&AddressCreateRequest{ProjectID: "p1", Body: AddressCreateBody{DdosProtection: true}}).Do(ctx, cli)
+			if err == nil && rsp.Result.ID != "x1" {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodPost, "/v2/projects/p1/addresses", `{"ddos_protection":true}`}},
+		{"detail", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&AddressDetailRequest{AddressID: "a1"}).Do(ctx, cli)
+			if err == nil && rsp.Result.ID != "x1" {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodGet, "/v2/addresses/a1/detail", ``}},
+		{"list", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&AddressListRequest{ProjectID: "p1"}).Do(ctx, cli)
+			if err == nil && rsp.Count != 1 {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodGet, "/v2/projects/p1/addresses", ``}},
+		{"delete", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&AddressDeleteRequest{AddressID: "a1"}).Do(ctx, cli)
+			return err
+		}, call{http.MethodDelete, "/v2/addresses/a1", ``}},
+		{"attach", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&AddressAttachRequest{AddressID: "a1", Body: AddressAttachBody{Entity: "server", ID: "s1"}}).Do(ctx, cli)
+			return err
+		}, call{http.MethodPost, "/v2/addresses/a1/attach", `{"entity":"server","id":"s1"}`}},
+		{"detach", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&AddressDetachRequest{AddressID: "a1"}).Do(ctx, cli)
+			return err
+		}, call{http.MethodPost, "/v2/addresses/a1/detach", ``}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reply := `{"result":{"id":"x1"}}`
+			if tc.name == "list" {
+				reply = `{"count":1,"result":[]}`
+			}
+			cli, got := apiServer(t, reply)
+			if err := tc.do(context.Background(), cli); err != nil {
+				t.Fatal(err)
+			}
+			if *got != tc.want {
+				t.Errorf("request = %+v, want %+v", *got, tc.want)
+			}
+		})
+	}
+}
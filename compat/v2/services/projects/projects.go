@@ -0,0 +1,110 @@
+// Package projects keeps the v2 project requests, sent through the v3 client.
+// See package clo for how the adapter maps v2 onto v3.
+package projects
+
+import (
+	"context"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+)
+
+type (
+	ProjectCreateResponse = clo.Response[clo.ResponseCreated]
+	ProjectDetailResponse = clo.Response[Project]
+	ProjectListResponse   = clo.ListResponse[Project]
+	ProjectDeleteResponse = clo.ActionResponse
+)
+
+// Project is a project as v2 returned it. Times are RFC 3339 strings.
+type Project struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	DisplayName    string `json:"display_name"`
+	Description    string `json:"description"`
+	Status         string `json:"status"`
+	SwitchStatus   string `json:"switch_status"`
+	StoppingReason string `json:"stopping_reason"`
+	HasAbuse       bool   `json:"has_abuse"`
+	Created        string `json:"created"`
+}
+
+// ProjectCreateBody is the body of ProjectCreateRequest.
+type ProjectCreateBody struct {
+	DisplayName string `json:"display_name,omitempty"`
+	Description string `json:"description,omitempty"`
+	LimitName   int    `json:"limit_name,omitempty"`
+}
+
+// ProjectCreateRequest creates a project.
+type ProjectCreateRequest struct {
+	clo.Request
+	Body ProjectCreateBody
+}
+
+func (r *ProjectCreateRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ProjectCreateResponse, error) {
+	body, err := clo.Convert[cloapi.ProjectCreateJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := clo.Do(ctx, &r.Request, "ProjectCreate", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ProjectCreateResponse, error) {
+		return cli.V3().ProjectCreateWithResponse(ctx, body, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &ProjectCreateResponse{}, nil
+	}
+	return clo.Result[clo.ResponseCreated](rsp.OK.Result)
+}
+
+// ProjectDetailRequest reads a project.
+type ProjectDetailRequest struct {
+	clo.Request
+	ProjectID string
+}
+
+func (r *ProjectDetailRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ProjectDetailResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "ProjectDetail", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ProjectDetailResponse, error) {
+		return cli.V3().ProjectDetailWithResponse(ctx, r.ProjectID, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &ProjectDetailResponse{}, nil
+	}
+	return clo.Result[Project](rsp.OK.Result)
+}
+
+// ProjectListRequest lists the account's projects, one page at a time; see
+// clo.NewPaginator.
+type ProjectListRequest struct {
+	clo.Request
+}
+
+func (r *ProjectListRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ProjectListResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "ProjectList", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ProjectListResponse, error) {
+		return cli.V3().ProjectListWithResponse(ctx, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &ProjectListResponse{}, nil
+	}
+	return clo.List[Project](rsp.OK.Count, rsp.OK.Result)
+}
+
+// ProjectDeleteRequest deletes a project.
+type ProjectDeleteRequest struct {
+	clo.Request
+	ProjectID string
+}
+
+func (r *ProjectDeleteRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ProjectDeleteResponse, error) {
+	return clo.Action(ctx, &r.Request, "ProjectDelete", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ProjectDeleteResponse, error) {
+		return cli.V3().ProjectDeleteWithResponse(ctx, r.ProjectID, editors...)
+	})
+}
@@ -0,0 +1,231 @@
+// Package servers keeps the v2 server requests, sent through the v3 client. See
+// package clo for how the adapter maps v2 onto v3.
+package servers
+
+import (
+	"context"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+)
+
+type (
+	ServerCreateResponse = clo.Response[clo.ResponseCreated]
+	ServerDetailResponse = clo.Response[Server]
+	ServerListResponse   = clo.ListResponse[Server]
+	ServerDeleteResponse = clo.ActionResponse
+	ServerStartResponse  = clo.ActionResponse
+	ServerStopResponse   = clo.ActionResponse
+	ServerRebootResponse = clo.ActionResponse
+)
+
+// Server is a server as v2 returned it. Times are RFC 3339 strings.
+type Server struct {
+	ID             string       `json:"id"`
+	Name           string       `json:"name"`
+	Status         string       `json:"status"`
+	SwitchStatus   string       `json:"switch_status"`
+	CreatedIn      string       `json:"created_in"`
+	Project        string       `json:"project"`
+	Datacenter     string       `json:"datacenter"`
+	PrimaryAddress string       `json:"primary_address"`
+	Addresses      []string     `json:"addresses"`
+	Snapshots      []string     `json:"snapshots"`
+	Flavor         ServerFlavor `json:"flavor"`
+	Image          ServerImage  `json:"image"`
+	Recipe         ServerRecipe `json:"recipe"`
+	DiskData       []ServerDisk `json:"disk_data"`
+	GuestAgent     bool         `json:"guest_agent"`
+	RescueMode     string       `json:"rescue_mode"`
+	AdminKeyStatus string       `json:"admin_key_status"`
+}
+
+type ServerFlavor struct {
+	Ram     int    `json:"ram"`
+	Vcpus   int    `json:"vcpus"`
+	Disk    int    `json:"disk"`
+	CpuType string `json:"cpu_type"`
+}
+
+type ServerImage struct {
+	MinDisk         int                   `json:"min_disk"`
+	MinRam          int                   `json:"min_ram"`
+	OperationSystem ServerOperationSystem `json:"operation_system"`
+}
+
+type ServerOperationSystem struct {
+	Distribution string `json:"distribution"`
+	OsFamily     string `json:"os_family"`
+	Version      string `json:"version"`
+}
+
+type ServerRecipe struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	MinDisk  int    `json:"min_disk"`
+	MinRam   int    `json:"min_ram"`
+	MinVcpus int    `json:"min_vcpus"`
+}
+
+type ServerDisk struct {
+	ID          string `json:"id"`
+	StorageType string `json:"storage_type"`
+}
+
+// ServerCreateBody is the body of ServerCreateRequest.
+type ServerCreateBody struct {
+	Name      string              `json:"name"`
+	Image     string              `json:"image,omitempty"`
+	Volume    string              `json:"volume,omitempty"`
+	Recipe    string              `json:"recipe,omitempty"`
+	UserData  string              `json:"user_data,omitempty"`
+	Flavor    ServerFlavorBody    `json:"flavor"`
+	Storages  []ServerStorageBody `json:"storages,omitempty"`
+	Addresses []ServerAddressBody `json:"addresses,omitempty"`
+	Keypairs  []string            `json:"keypairs,omitempty"`
+}
+
+type ServerFlavorBody struct {
+	Ram     int    `json:"ram"`
+	Vcpus   int    `json:"vcpus"`
+	CpuType string `json:"cpu_type,omitempty"`
+}
+
+type ServerStorageBody struct {
+	Size        int    `json:"size"`
+	Bootable    bool   `json:"bootable,omitempty"`
+	StorageType string `json:"storage_type,omitempty"`
+}
+
+type ServerAddressBody struct {
+	AddressID        string `json:"address_id,omitempty"`
+	External         bool   `json:"external,omitempty"`
+	DdosProtection   bool   `json:"ddos_protection,omitempty"`
+	Version          int    `json:"version,omitempty"`
+	BandwidthMaxMbps int    `json:"bandwidth_max_mbps,omitempty"`
+}
+
+// ServerCreateRequest creates a server in a project.
+type ServerCreateRequest struct {
+	clo.Request
+	ProjectID string
+	Body      ServerCreateBody
+}
+
+func (r *ServerCreateRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ServerCreateResponse, error) {
+	body, err := clo.Convert[cloapi.ServerCreateJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := clo.Do(ctx, &r.Request, "ServerCreate", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ServerCreateResponse, error) {
+		return cli.V3().ServerCreateWithResponse(ctx, r.ProjectID, body, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &ServerCreateResponse{}, nil
+	}
+	return clo.Result[clo.ResponseCreated](rsp.OK.Result)
+}
+
+// ServerDetailRequest reads a server.
+type ServerDetailRequest struct {
+	clo.Request
+	ServerID string
+}
+
+func (r *ServerDetailRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ServerDetailResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "ServerDetail", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ServerDetailResponse, error) {
+		return cli.V3().ServerDetailWithResponse(ctx, r.ServerID, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &ServerDetailResponse{}, nil
+	}
+	return clo.Result[Server](rsp.OK.Result)
+}
+
+// ServerListRequest lists the servers of a project, one page at a time; see
+// clo.NewPaginator.
+type ServerListRequest struct {
+	clo.Request
+	ProjectID string
+}
+
+func (r *ServerListRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ServerListResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "ProjectServerList", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ProjectServerListResponse, error) {
+		return cli.V3().ProjectServerListWithResponse(ctx, r.ProjectID, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &ServerListResponse{}, nil
+	}
+	return clo.List[Server](rsp.OK.Count, rsp.OK.Result)
+}
+
+// ServerDeleteBody is the optional body of ServerDeleteRequest. DeleteAddresses
+// and DeleteVolumes list the IDs of the server's addresses and volumes to delete
+// with it.
+type ServerDeleteBody struct {
+	ClearFstab      bool     `json:"clear_fstab,omitempty"`
+	DeleteAddresses []string `json:"delete_addresses,omitempty"`
+	DeleteVolumes   []string `json:"delete_volumes,omitempty"`
+}
+
+// ServerDeleteRequest deletes a server.
+type ServerDeleteRequest struct {
+	clo.Request
+	ServerID string
+	Body     ServerDeleteBody
+}
+
+func (r *ServerDeleteRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ServerDeleteResponse, error) {
+	body, err := clo.Convert[cloapi.ServerDeleteJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return clo.Action(ctx, &r.Request, "ServerDelete", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ServerDeleteResponse, error) {
+		return cli.V3().ServerDeleteWithResponse(ctx, r.ServerID, body, editors...)
+	})
+}
+
+// ServerStartRequest starts a stopped server.
+type ServerStartRequest struct {
+	clo.Request
+	ServerID string
+}
+
+func (r *ServerStartRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ServerStartResponse, error) {
+	return clo.Action(ctx, &r.Request, "ServerStart", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ServerStartResponse, error) {
+		return cli.V3().ServerStartWithResponse(ctx, r.ServerID, editors...)
+	})
+}
+
+// ServerStopRequest stops a server.
+type ServerStopRequest struct {
+	clo.Request
+	ServerID string
+}
+
+func (r *ServerStopRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ServerStopResponse, error) {
+	return clo.Action(ctx, &r.Request, "ServerStop", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ServerStopResponse, error) {
+		return cli.V3().ServerStopWithResponse(ctx, r.ServerID, editors...)
+	})
+}
+
+// ServerRebootRequest reboots a server.
+type ServerRebootRequest struct {
+	clo.Request
+	ServerID string
+}
+
+func (r *ServerRebootRequest) Do(ctx context.Context, cli *clo.ApiClient) (*ServerRebootResponse, error) {
+	return clo.Action(ctx, &r.Request, "ServerReboot", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ServerRebootResponse, error) {
+		return cli.V3().ServerRebootWithResponse(ctx, r.ServerID, editors...)
+	})
+}
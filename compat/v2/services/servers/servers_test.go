@@ -0,0 +1,113 @@
+package servers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+)
+
+type call struct {
+	method, path, body string
+}
+
+func apiServer(t *testing.T, reply string) (*clo.ApiClient, *call) {
+	t.Helper()
+	got := &call{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		*got = call{r.Method, r.URL.Path, string(b)}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, reply)
+	}))
+	t.Cleanup(srv.Close)
+	cli, err := clo.NewDefaultClient("tok", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli, got
+}
+
+func TestServerCreate(t *testing.T) {
+	cli, got := apiServer(t, `{"result":{"id":"s1"}}`)
+	req := ServerCreateRequest{
+		ProjectID: "p1",
+		Body: ServerCreateBody{
+			Name:     "my_server",
+			Image:    "img",
+			Flavor:   ServerFlavorBody{Ram: 2048, Vcpus: 2},
+			Storages: []ServerStorageBody{{Size: 20, Bootable: true}},
+		},
+	}
+	rsp, err := req.Do(context.Background(), cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Result.ID != "s1" {
+		t.Errorf("Result = %+v", rsp.Result)
+	}
+	want := call{http.MethodPost, "/v2/projects/p1/servers",
+		`{"flavor":{"ram":2048,"vcpus":2},"image":"img","name":"my_server","storages":[{"bootable":true,"size":20}]}`}
+	if *got != want {
+		t.Errorf("request = %+v, want %+v", *got, want)
+	}
+}
+
+func TestServerRequests(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		do   func(context.Context, *clo.ApiClient) error
+		want call
+	}{
+		{"detail", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&ServerDetailRequest{ServerID: "s1"}).Do(ctx, cli)
+			return err
+		}, call{http.MethodGet, "/v2/servers/s1/detail", ""}},
+		{"list", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&ServerListRequest{ProjectID: "p1"}).Do(ctx, cli)
+			return err
+		}, call{http.MethodGet, "/v2/projects/p1/servers", ""}},
+		{"delete", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&ServerDeleteRequest{ServerID: "s1", Body: ServerDeleteBody{DeleteVolumes: []string{"v1"}}}).Do(ctx, cli)
+			return err
+		}, call{http.MethodDelete, "/v2/servers/s1", `{"delete_volumes":["v1"]}`}},
+		{"start", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&ServerStartRequest{ServerID: "s1"}).Do(ctx, cli)
+			return err
+		}, call{http.MethodPost, "/v2/servers/s1/start", ""}},
+		{"stop", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&ServerStopRequest{ServerID: "s1"}).Do(ctx, cli)
+			return err
+		}, call{http.MethodPost, "/v2/servers/s1/stop", ""}},
+		{"reboot", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&ServerRebootRequest{ServerID: "s1"}).Do(ctx, cli)
+			return err
+		}, call{http.MethodPost, "/v2/servers/s1/reboot", ""}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cli, got := apiServer(t, `{}`)
+			if err := tc.do(context.Background(), cli); err != nil {
+				t.Fatal(err)
+			}
+			if *got != tc.want {
+				t.Errorf("request = %+v, want %+v", *got, tc.want)
+			}
+		})
+	}
+}
+
+func TestServerDetailV2Shape(t *testing.T) {
+	cli, _ := apiServer(t, `{"result":{"id":"s1","name":"web","created_in":"2026-01-02T03:04:05Z",
+		"flavor":{"ram":2048,"vcpus":2,"cpu_type":"common"},"primary_address":null,"disk_data":[{"id":"v1","storage_type":"ssd"}]}}`)
+	rsp, err := (&ServerDetailRequest{ServerID: "s1"}).Do(context.Background(), cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := rsp.Result
+	if s.ID != "s1" || s.CreatedIn != "2026-01-02T03:04:05Z" || s.Flavor.Ram != 2048 || s.PrimaryAddress != "" || s.DiskData[0].ID != "v1" {
+		t.Errorf("Result = %+v", s)
+	}
+}
@@ -0,0 +1,140 @@
+// Package snapshots keeps the v2 server snapshot requests, sent through the v3
+// client. See package clo for how the adapter maps v2 onto v3.
+package snapshots
+
+import (
+	"context"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+)
+
+type (
+	SnapshotCreateResponse  = clo.Response[clo.ResponseCreated]
+	SnapshotDetailResponse  = clo.Response[Snapshot]
+	SnapshotListResponse    = clo.ListResponse[Snapshot]
+	SnapshotRestoreResponse = clo.Response[clo.ResponseCreated]
+	SnapshotDeleteResponse  = clo.ActionResponse
+)
+
+// Snapshot is a server snapshot as v2 returned it. Times are RFC 3339 strings.
+type Snapshot struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Status       string   `json:"status"`
+	Size         int      `json:"size"`
+	ParentServer string   `json:"parent_server"`
+	ChildServers []string `json:"child_servers"`
+	CreatedIn    string   `json:"created_in"`
+	DeletedIn    string   `json:"deleted_in"`
+}
+
+// SnapshotCreateBody is the body of SnapshotCreateRequest.
+type SnapshotCreateBody struct {
+	Name string `json:"name"`
+}
+
+// SnapshotCreateRequest snapshots a server.
+type SnapshotCreateRequest struct {
+	clo.Request
+	ServerID string
+	Body     SnapshotCreateBody
+}
+
+func (r *SnapshotCreateRequest) Do(ctx context.Context, cli *clo.ApiClient) (*SnapshotCreateResponse, error) {
+	body, err := clo.Convert[cloapi.CreateServerSnapshotJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := clo.Do(ctx, &r.Request, "CreateServerSnapshot", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.CreateServerSnapshotResponse, error) {
+		return cli.V3().CreateServerSnapshotWithResponse(ctx, r.ServerID, body, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &SnapshotCreateResponse{}, nil
+	}
+	return clo.Result[clo.ResponseCreated](rsp.OK.Result)
+}
+
+// SnapshotDetailRequest reads a snapshot.
+type SnapshotDetailRequest struct {
+	clo.Request
+	SnapshotID string
+}
+
+func (r *SnapshotDetailRequest) Do(ctx context.Context, cli *clo.ApiClient) (*SnapshotDetailResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "SnapshotDetails", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.SnapshotDetailsResponse, error) {
+		return cli.V3().SnapshotDetailsWithResponse(ctx, r.SnapshotID, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &SnapshotDetailResponse{}, nil
+	}
+	return clo.Result[Snapshot](rsp.OK.Result)
+}
+
+// SnapshotListRequest lists the snapshots of a project, one page at a time; see
+// clo.NewPaginator.
+type SnapshotListRequest struct {
+	clo.Request
+	ProjectID string
+}
+
+func (r *SnapshotListRequest) Do(ctx context.Context, cli *clo.ApiClient) (*SnapshotListResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "SnapshotsList", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.SnapshotsListResponse, error) {
+		return cli.V3().SnapshotsListWithResponse(ctx, r.ProjectID, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &SnapshotListResponse{}, nil
+	}
+	return clo.List[Snapshot](rsp.OK.Count, rsp.OK.Result)
+}
+
+// SnapshotDeleteRequest deletes a snapshot.
+type SnapshotDeleteRequest struct {
+	clo.Request
+	SnapshotID string
+}
+
+func (r *SnapshotDeleteRequest) Do(ctx context.Context, cli *clo.ApiClient) (*SnapshotDeleteResponse, error) {
+	return clo.Action(ctx, &r.Request, "SnapshotDelete", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.SnapshotDeleteResponse, error) {
+		return cli.V3().SnapshotDeleteWithResponse(ctx, r.SnapshotID, editors...)
+	})
+}
+
+// SnapshotRestoreBody is the body of SnapshotRestoreRequest: the name of the
+// server restored from the snapshot.
+type SnapshotRestoreBody struct {
+	Name string `json:"name"`
+}
+
+// SnapshotRestoreRequest creates a server from a snapshot.
+type SnapshotRestoreRequest struct {
+	clo.Request
+	SnapshotID string
+	Body       SnapshotRestoreBody
+}
+
+func (r *SnapshotRestoreRequest) Do(ctx context.Context, cli *clo.ApiClient) (*SnapshotRestoreResponse, error) {
+	body, err := clo.Convert[cloapi.SnapshotRestoreJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := clo.Do(ctx, &r.Request, "SnapshotRestore", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.SnapshotRestoreResponse, error) {
+		return cli.V3().SnapshotRestoreWithResponse(ctx, r.SnapshotID, body, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &SnapshotRestoreResponse{}, nil
+	}
+	return clo.Result[clo.ResponseCreated](rsp.OK.Result)
+}
@@ -0,0 +1,88 @@
+package snapshots
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+)
+
+type call struct {
+	method, path, body string
+}
+
+func apiServer(t *testing.T, reply string) (*clo.ApiClient, *call) {
+	t.Helper()
+	got := &call{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		*got = call{r.Method, r.URL.Path, string(b)}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, reply)
+	}))
+	t.Cleanup(srv.Close)
+	cli, err := clo.NewDefaultClient("tok", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli, got
+}
+
+func TestSnapshotRequests(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		do   func(context.Context, *clo.ApiClient) error
+		want call
+	}{
+		{"create", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&SnapshotCreateRequest{ServerID: "s1", Body: SnapshotCreateBody{Name: "nightly"}}).Do(ctx, cli)
+			if err == nil && rsp.Result.ID != "x1" {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodPost, "/v2/servers/s1/snapshot", `{"name":"nightly"}`}},
+		{"detail", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&SnapshotDetailRequest{SnapshotID: "n1"}).Do(ctx, cli)
+			if err == nil && rsp.Result.ID != "x1" {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodGet, "/v2/snapshots/n1/detail", ``}},
+		{"list", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&SnapshotListRequest{ProjectID: "p1"}).Do(ctx, cli)
+			if err == nil && rsp.Count != 1 {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodGet, "/v2/projects/p1/snapshots", ``}},
+		{"delete", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&SnapshotDeleteRequest{SnapshotID: "n1"}).Do(ctx, cli)
+			return err
+		}, call{http.MethodDelete, "/v2/snapshots/n1", ``}},
+		{"restore", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&SnapshotRestoreRequest{SnapshotID: "n1", Body: SnapshotRestoreBody{Name: "restored"}}).Do(ctx, cli)
+			if err == nil && rsp.Result.ID != "x1" {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodPost, "/v2/snapshots/n1/restore", `{"name":"restored"}`}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reply := `{"result":{"id":"x1"}}`
+			if tc.name == "list" {
+				reply = `{"count":1,"result":[]}`
+			}
+			cli, got := apiServer(t, reply)
+			if err := tc.do(context.Background(), cli); err != nil {
+				t.Fatal(err)
+			}
+			if *got != tc.want {
+				t.Errorf("request = %+v, want %+v", *got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// Package volumes keeps the v2 volume requests, sent through the v3 client. See
+// package clo for how the adapter maps v2 onto v3.
+package volumes
+
+import (
+	"context"
+
+	cloapi "github.com/clo-ru/cloapi-go-client/v3"
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+)
+
+type (
+	VolumeCreateResponse = clo.Response[clo.ResponseCreated]
+	VolumeDetailResponse = clo.Response[Volume]
+	VolumeListResponse   = clo.ListResponse[Volume]
+	VolumeDeleteResponse = clo.ActionResponse
+	VolumeAttachResponse = clo.ActionResponse
+	VolumeDetachResponse = clo.ActionResponse
+	VolumeExtendResponse = clo.ActionResponse
+)
+
+// Volume is a volume as v2 returned it. Times are RFC 3339 strings.
+type Volume struct {
+	ID               string           `json:"id"`
+	Name             string           `json:"name"`
+	Description      string           `json:"description"`
+	Status           string           `json:"status"`
+	Size             int              `json:"size"`
+	Bootable         bool             `json:"bootable"`
+	Undetachable     bool             `json:"undetachable"`
+	FileSystem       string           `json:"file_system"`
+	CreatedIn        string           `json:"created_in"`
+	Snapshots        []string         `json:"snapshots"`
+	AttachedToServer VolumeAttachment `json:"attached_to_server"`
+}
+
+// VolumeAttachment is the server a volume is attached to, empty if none.
+type VolumeAttachment struct {
+	ID     string `json:"id"`
+	Device string `json:"device"`
+}
+
+// VolumeCreateBody is the body of VolumeCreateRequest. Size is in GB.
+type VolumeCreateBody struct {
+	Name       string `json:"name"`
+	Size       int    `json:"size"`
+	Autorename bool   `json:"autorename,omitempty"`
+}
+
+// VolumeCreateRequest creates a volume in a project.
+type VolumeCreateRequest struct {
+	clo.Request
+	ProjectID string
+	Body      VolumeCreateBody
+}
+
+func (r *VolumeCreateRequest) Do(ctx context.Context, cli *clo.ApiClient) (*VolumeCreateResponse, error) {
+	body, err := clo.Convert[cloapi.VolumeCreateJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := clo.Do(ctx, &r.Request, "VolumeCreate", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.VolumeCreateResponse, error) {
+		return cli.V3().VolumeCreateWithResponse(ctx, r.ProjectID, body, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &VolumeCreateResponse{}, nil
+	}
+	return clo.Result[clo.ResponseCreated](rsp.OK.Result)
+}
+
+// VolumeDetailRequest reads a volume.
+type VolumeDetailRequest struct {
+	clo.Request
+	VolumeID string
+}
+
+func (r *VolumeDetailRequest) Do(ctx context.Context, cli *clo.ApiClient) (*VolumeDetailResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "VolumeDetail", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.VolumeDetailResponse, error) {
+		return cli.V3().VolumeDetailWithResponse(ctx, r.VolumeID, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &VolumeDetailResponse{}, nil
+	}
+	return clo.Result[Volume](rsp.OK.Result)
+}
+
+// VolumeListRequest lists the volumes of a project, one page at a time; see
+// clo.NewPaginator.
+type VolumeListRequest struct {
+	clo.Request
+	ProjectID string
+}
+
+func (r *VolumeListRequest) Do(ctx context.Context, cli *clo.ApiClient) (*VolumeListResponse, error) {
+	rsp, err := clo.Do(ctx, &r.Request, "ProjectVolumesList", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.ProjectVolumesListResponse, error) {
+		return cli.V3().ProjectVolumesListWithResponse(ctx, r.ProjectID, editors...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.OK == nil {
+		return &VolumeListResponse{}, nil
+	}
+	return clo.List[Volume](rsp.OK.Count, rsp.OK.Result)
+}
+
+// VolumeDeleteBody is the optional body of VolumeDeleteRequest.
+type VolumeDeleteBody struct {
+	ClearFstab bool `json:"clear_fstab,omitempty"`
+	Force      bool `json:"force,omitempty"`
+}
+
+// VolumeDeleteRequest deletes a volume.
+type VolumeDeleteRequest struct {
+	clo.Request
+	VolumeID string
+	Body     VolumeDeleteBody
+}
+
+func (r *VolumeDeleteRequest) Do(ctx context.Context, cli *clo.ApiClient) (*VolumeDeleteResponse, error) {
+	body, err := clo.Convert[cloapi.VolumeDeleteJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return clo.Action(ctx, &r.Request, "VolumeDelete", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.VolumeDeleteResponse, error) {
+		return cli.V3().VolumeDeleteWithResponse(ctx, r.VolumeID, body, editors...)
+	})
+}
+
+// VolumeAttachBody is the body of VolumeAttachRequest.
+type VolumeAttachBody struct {
+	ServerID string `json:"server_id"`
+}
+
+// VolumeAttachRequest attaches a volume to a server.
+type VolumeAttachRequest struct {
+	clo.Request
+	VolumeID string
+	Body     VolumeAttachBody
+}
+
+func (r *VolumeAttachRequest) Do(ctx context.Context, cli *clo.ApiClient) (*VolumeAttachResponse, error) {
+	body, err := clo.Convert[cloapi.VolumeAttachJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return clo.Action(ctx, &r.Request, "VolumeAttach", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.VolumeAttachResponse, error) {
+		return cli.V3().VolumeAttachWithResponse(ctx, r.VolumeID, body, editors...)
+	})
+}
+
+// VolumeDetachBody is the optional body of VolumeDetachRequest.
+type VolumeDetachBody struct {
+	ClearFstab bool `json:"clear_fstab,omitempty"`
+	Force      bool `json:"force,omitempty"`
+}
+
+// VolumeDetachRequest detaches a volume from its server.
+type VolumeDetachRequest struct {
+	clo.Request
+	VolumeID string
+	Body     VolumeDetachBody
+}
+
+func (r *VolumeDetachRequest) Do(ctx context.Context, cli *clo.ApiClient) (*VolumeDetachResponse, error) {
+	body, err := clo.Convert[cloapi.VolumeDetachJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return clo.Action(ctx, &r.Request, "VolumeDetach", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.VolumeDetachResponse, error) {
+		return cli.V3().VolumeDetachWithResponse(ctx, r.VolumeID, body, editors...)
+	})
+}
+
+// VolumeExtendBody is the body of VolumeExtendRequest. NewSize is in GB.
+type VolumeExtendBody struct {
+	NewSize      int  `json:"new_size"`
+	RebootServer bool `json:"reboot_server,omitempty"`
+}
+
+// VolumeExtendRequest grows a volume.
+type VolumeExtendRequest struct {
+	clo.Request
+	VolumeID string
+	Body     VolumeExtendBody
+}
+
+func (r *VolumeExtendRequest) Do(ctx context.Context, cli *clo.ApiClient) (*VolumeExtendResponse, error) {
+	body, err := clo.Convert[cloapi.VolumeExtendJSONRequestBody](r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return clo.Action(ctx, &r.Request, "VolumeExtend", func(ctx context.Context, editors ...cloapi.RequestEditorFn) (*cloapi.VolumeExtendResponse, error) {
+		return cli.V3().VolumeExtendWithResponse(ctx, r.VolumeID, body, editors...)
+	})
+}
@@ -0,0 +1,93 @@
+package volumes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clo-ru/cloapi-go-client/v3/compat/v2/clo"
+)
+
+type call struct {
+	method, path, body string
+}
+
+func apiServer(t *testing.T, reply string) (*clo.ApiClient, *call) {
+	t.Helper()
+	got := &call{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		*got = call{r.Method, r.URL.Path, string(b)}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, reply)
+	}))
+	t.Cleanup(srv.Close)
+	cli, err := clo.NewDefaultClient("tok", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli, got
+}
+
+func TestVolumeRequests(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		do   func(context.Context, *clo.ApiClient) error
+		want call
+	}{
+		{"create", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&VolumeCreateRequest{ProjectID: "p1", Body: VolumeCreateBody{Name: "data", Size: 10}}).Do(ctx, cli)
+			if err == nil && rsp.Result.ID != "x1" {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodPost, "/v2/projects/p1/volumes", `{"name":"data","size":10}`}},
+		{"detail", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&VolumeDetailRequest{VolumeID: "v1"}).Do(ctx, cli)
+			if err == nil && rsp.Result.ID != "x1" {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodGet, "/v2/volumes/v1/detail", ``}},
+		{"list", func(ctx context.Context, cli *clo.ApiClient) error {
+			rsp, err := (&VolumeListRequest{ProjectID: "p1"}).Do(ctx, cli)
+			if err == nil && rsp.Count != 1 {
+				return fmt.Errorf("result = %+v", rsp.Result)
+			}
+			return err
+		}, call{http.MethodGet, "/v2/projects/p1/volumes", ``}},
+		{"delete", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&VolumeDeleteRequest{VolumeID: "v1", Body: VolumeDeleteBody{Force: true}}).Do(ctx, cli)
+			return err
+		}, call{http.MethodDelete, "/v2/volumes/v1", `{"force":true}`}},
+		{"attach", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&VolumeAttachRequest{VolumeID: "v1", Body: VolumeAttachBody{ServerID: "s1"}}).Do(ctx, cli)
+			return err
+		}, call{http.MethodPost, "/v2/volumes/v1/attach", `{"server_id":"s1"}`}},
+		{"detach", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&VolumeDetachRequest{VolumeID: "v1"}).Do(ctx, cli)
+			return err
+		}, call{http.MethodPost, "/v2/volumes/v1/detach", `{}`}},
+		{"extend", func(ctx context.Context, cli *clo.ApiClient) error {
+			_, err := (&VolumeExtendRequest{VolumeID: "v1", Body: VolumeExtendBody{NewSize: 20}}).Do(ctx, cli)
+			return err
+		}, call{http.MethodPost, "/v2/volumes/v1/extend", `{"new_size":20}`}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reply := `{"result":{"id":"x1"}}`
+			if tc.name == "list" {
+				reply = `{"count":1,"result":[]}`
+			}
+			cli, got := apiServer(t, reply)
+			if err := tc.do(context.Background(), cli); err != nil {
+				t.Fatal(err)
+			}
+			if *got != tc.want {
+				t.Errorf("request = %+v, want %+v", *got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package cloapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+)
+
+// acceptEncoding is what the decompression transport asks the API for.
+const acceptEncoding = "br, gzip"
+
+// maxDecodedBytes caps a decoded response body, so a small compressed payload
+// cannot expand without bound.
+const maxDecodedBytes = 256 << 20
+
+// decompressRoundTripper asks for compressed responses and decodes them. Setting
+// Accept-Encoding by hand turns off net/http's built-in gzip handling, so this
+// owns decoding entirely, for brotli too. Bodies are decoded as they are read,
+// up to MaxBytes (maxDecodedBytes if 0).
+type decompressRoundTripper struct {
+	Proxied  http.RoundTripper
+	MaxBytes int64
+}
+
+// decodedBody is a response body decoded by decompressRoundTripper. The decoder
+// is created on the first Read, as net/http's gzipReader does, since creating a
+// gzip reader already reads the header. It counts the bytes read off the wire
+// and decoded, and hands them to report once the body is drained or closed, for
+// the logging transport.
+type decodedBody struct {
+	newDecoder func(io.Reader) (io.Reader, error)
+	decoder    io.Reader
+	wire       *countingReader
+	raw        io.Closer
+	encoding   string
+	size       int64
+	max        int64
+	report     func(*decodedBody)
+	once       sync.Once
+}
+
+func (b *decodedBody) Read(p []byte) (int, error) {
+	if b.decoder == nil {
+		r, err := b.newDecoder(b.wire)
+		switch {
+		case err == io.EOF && b.wire.n == 0:
+			// An empty body despite the Content-Encoding: nothing to decode.
+			b.done()
+			return 0, io.EOF
+		case err != nil:
+			b.done()
+			return 0, fmt.Errorf("cloapi: %s response: %w", b.encoding, err)
+		}
+		b.decoder = r
+	}
+	n, err := b.decoder.Read(p)
+	b.size += int64(n)
+	if b.size > b.max {
+		n, err = n-int(b.size-b.max), fmt.Errorf("cloapi: %s response exceeds %d bytes decoded", b.encoding, b.max)
+		b.size = b.max
+	}
+	if err != nil {
+		b.done()
+	}
+	return n, err
+}
+
+func (b *decodedBody) Close() error {
+	b.done()
+	return b.raw.Close()
+}
+
+func (b *decodedBody) done() {
+	b.once.Do(func() {
+		if b.report != nil {
+			b.report(b)
+		}
+	})
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (d *decompressRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Encoding") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	resp, err := d.Proxied.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	enc := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	if enc == "" || enc == "identity" || !hasBody(req, resp) {
+		return resp, nil
+	}
+
+	var newDecoder func(io.Reader) (io.Reader, error)
+	switch enc {
+	case "gzip":
+		newDecoder = func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) }
+	case "br":
+		newDecoder = func(r io.Reader) (io.Reader, error) { return brotli.NewReader(r), nil }
+	default:
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("cloapi: unsupported Content-Encoding %q", enc)
+	}
+	limit := d.MaxBytes
+	if limit <= 0 {
+		limit = maxDecodedBytes
+	}
+
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	wire := &countingReader{Reader: resp.Body}
+	resp.Body = &decodedBody{newDecoder: newDecoder, wire: wire, raw: resp.Body, encoding: enc, max: limit}
+	return resp, nil
+}
+
+// hasBody reports whether resp may carry a body to decode: responses to HEAD,
+// 204 and 304 never do, whatever their Content-Encoding says.
+func hasBody(req *http.Request, resp *http.Response) bool {
+	return req.Method != http.MethodHead && resp.StatusCode != http.StatusNoContent &&
+		resp.StatusCode != http.StatusNotModified && resp.ContentLength != 0
+}
+
+// conditionalRoundTripper remembers the ETag and Last-Modified of GET responses
+// and revalidates with If-None-Match / If-Modified-Since. A 304 is answered from
+// the stored copy as a 200, so the Parse*Response functions never see it.
+type conditionalRoundTripper struct {
+	Proxied http.RoundTripper
+	Store   CacheStore
+}
+
+func (c *conditionalRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Callers sending their own validators get the raw 304.
+	if req.Method != http.MethodGet || req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return c.Proxied.RoundTrip(req)
+	}
+
+	key := condKeyPrefix + req.URL.String()
+	stored, ok := c.Store.Get(key)
+	if ok {
+		req = req.Clone(req.Context())
+		if etag := stored.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lm := stored.Header.Get("Last-Modified"); lm != "" {
+			req.Header.Set("If-Modified-Since", lm)
+		}
+	}
+
+	resp, err := c.Proxied.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotModified && ok {
+		drainAndClose(resp)
+		return stored.response(req), nil
+	}
+	if resp.StatusCode != http.StatusOK || (resp.Header.Get("ETag") == "" && resp.Header.Get("Last-Modified") == "") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	c.Store.Set(key, &CacheEntry{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: body})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
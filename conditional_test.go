@@ -0,0 +1,225 @@
+package cloapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/andybalholm/brotli"
+)
+
+// bigList is a compressible list response.
+var bigList = `{"count":200,"result":[` + strings.TrimSuffix(strings.Repeat(`{"id":"a","name":"ubuntu-22.04"},`, 200), ",") + `]}`
+
+func compressed(t *testing.T, enc string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch enc {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "br":
+		w = brotli.NewWriter(&buf)
+	}
+	_, _ = io.WriteString(w, bigList)
+	_ = w.Close()
+	return buf.Bytes()
+}
+
+func TestCompression(t *testing.T) {
+	for _, enc := range []string{"gzip", "br"} {
+		t.Run(enc, func(t *testing.T) {
+			payload := compressed(t, enc)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !strings.Contains(r.Header.Get("Accept-Encoding"), enc) {
+					t.Errorf("Accept-Encoding = %q", r.Header.Get("Accept-Encoding"))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Encoding", enc)
+				_, _ = w.Write(payload)
+			}))
+			defer srv.Close()
+
+			var logs bytes.Buffer
+			cli, err := New("tok", WithBaseURL(srv.URL), WithCompression(), WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := cli.ProjectImagesListWithResponse(context.Background(), "p1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.OK == nil || resp.OK.Count != 200 || len(*resp.OK.Result) != 200 {
+				t.Fatalf("decoded response = %+v", resp.OK)
+			}
+			if !strings.Contains(logs.String(), "content_encoding="+enc) || !strings.Contains(logs.String(), "wire_bytes=") {
+				t.Errorf("log = %q, want sizes", logs.String())
+			}
+		})
+	}
+}
+
+func TestDecompressionStreamsAndCaps(t *testing.T) {
+	payload := compressed(t, "gzip")
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(payload[:len(payload)/2])
+		w.(http.Flusher).Flush()
+		<-release
+		_, _ = w.Write(payload[len(payload)/2:])
+	}))
+	defer srv.Close()
+
+	rt := &decompressRoundTripper{Proxied: http.DefaultTransport, MaxBytes: 1000}
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	// Returns before the body is complete: nothing is read up front.
+	resp, err := rt.RoundTrip(req)
+	close(release)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err == nil || !strings.Contains(err.Error(), "exceeds 1000 bytes") {
+		t.Fatalf("err = %v, want the decoded size cap", err)
+	}
+	if len(body) != 1000 || !strings.HasPrefix(bigList, string(body)) {
+		t.Errorf("read %d bytes before the cap", len(body))
+	}
+}
+
+func TestDecompressionWithoutBody(t *testing.T) {
+	payload := compressed(t, "gzip")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Some proxies label every response, even those without a body.
+		w.Header().Set("Content-Encoding", "gzip")
+		switch {
+		case r.Method == http.MethodHead:
+			w.Header().Set("Content-Length", strconv.Itoa(len(payload)))
+		case r.Header.Get("If-None-Match") == `"v1"`:
+			w.WriteHeader(http.StatusNotModified)
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write(payload)
+		}
+	}))
+	defer srv.Close()
+
+	cli, err := New("tok", WithBaseURL(srv.URL), WithCompression(), WithConditionalRequests(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if resp, err := cli.ServerStartWithResponse(ctx, "s1"); err != nil || resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("204: err = %v", err)
+	}
+	for i := range 2 { // the second is revalidated with a 304
+		resp, err := cli.ProjectImagesListWithResponse(ctx, "p1")
+		if err != nil || resp.OK == nil || resp.OK.Count != 200 {
+			t.Fatalf("GET %d: err = %v", i, err)
+		}
+	}
+
+	rt := &decompressRoundTripper{Proxied: http.DefaultTransport}
+	req, _ := http.NewRequest(http.MethodHead, srv.URL, nil)
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("HEAD: %v", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestConditionalRequests(t *testing.T) {
+	var full, notModified int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		atomic.AddInt32(&full, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = io.WriteString(w, bigList)
+	}))
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	cli, err := New("tok", WithBaseURL(srv.URL), WithConditionalRequests(nil), WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		resp, err := cli.ProjectImagesListWithResponse(context.Background(), "p1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusOK || resp.OK == nil || resp.OK.Count != 200 {
+			t.Fatalf("status %d, OK = %v", resp.StatusCode(), resp.OK != nil)
+		}
+	}
+	if full != 1 || notModified != 2 {
+		t.Errorf("full = %d, 304s = %d, want 1 and 2", full, notModified)
+	}
+	if strings.Count(logs.String(), "status=304") != 2 {
+		t.Errorf("log = %q, want the 304s logged", logs.String())
+	}
+}
+
+func TestCacheAndConditionalShareStore(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			_, _ = io.WriteString(w, `{"result":{"id":"new"}}`)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = io.WriteString(w, `{"count":0,"result":[]}`)
+	}))
+	defer srv.Close()
+
+	store := NewMemoryCache(10)
+	cli, err := New("tok", WithBaseURL(srv.URL), WithConditionalRequests(store),
+		WithCache(CacheConfig{TTLs: map[string]time.Duration{"/v2/projects/{id}/images": time.Minute}, Store: store}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := func() []string {
+		var keys []string
+		store.DeleteFunc(func(key string) bool {
+			keys = append(keys, strings.SplitN(key, ":", 2)[0])
+			return false
+		})
+		slices.Sort(keys)
+		return keys
+	}
+	ctx := context.Background()
+	if _, err := cli.ProjectImagesListWithResponse(ctx, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := keys(); !slices.Equal(got, []string{"cache", "cond"}) {
+		t.Errorf("keys = %v, want one entry per transport", got)
+	}
+
+	// A mutation drops the cache's entries of the project, not the validators.
+	if _, err := cli.VolumeCreateWithResponse(ctx, "p1", VolumeCreateJSONRequestBody{Name: "v"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := keys(); !slices.Equal(got, []string{"cond"}) {
+		t.Errorf("keys after mutation = %v", got)
+	}
+}
@@ -0,0 +1,224 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Kinds of objects the Ensure helpers manage, as reported in their errors.
+const (
+	KindServer  = "server"
+	KindVolume  = "volume"
+	KindProject = "project"
+	KindS3User  = "S3 user"
+)
+
+// ensureAttempts bounds how often an Ensure helper sends its create request when
+// the outcome of the previous one was unknown.
+const ensureAttempts = 3
+
+// EnsureResult is the outcome of an Ensure helper.
+type EnsureResult struct {
+	ID string
+	// Created is true if this call created the object, false if it adopted one
+	// that already existed under the name.
+	Created bool
+}
+
+// ensure implements find-or-create. find returns the IDs of objects named name.
+// After a create whose outcome is unknown, i.e. a transport error, a timeout, a
+// 5xx or a 409, it looks again before trying anew, so a request that did reach
+// the API is adopted instead of repeated.
+func ensure(ctx context.Context, kind, name string, find func(context.Context) ([]string, error), create func(context.Context) (string, error)) (EnsureResult, error) {
+	if name == "" {
+		return EnsureResult{}, fmt.Errorf("cloapi: ensure %s: name is required", kind)
+	}
+	ids, err := find(ctx)
+	if err != nil {
+		return EnsureResult{}, fmt.Errorf("cloapi: ensure %s %q: %w", kind, name, err)
+	}
+
+	var createErr error
+	for attempt := 0; ; attempt++ {
+		switch len(ids) {
+		case 0:
+		case 1:
+			// Found after a failed create: that create was ours.
+			return EnsureResult{ID: ids[0], Created: createErr != nil}, nil
+		default:
+			return EnsureResult{}, &AmbiguousNameError{Kind: kind, Name: name, IDs: ids}
+		}
+		if attempt == ensureAttempts {
+			return EnsureResult{}, fmt.Errorf("cloapi: ensure %s %q: giving up after %d attempts: %w", kind, name, attempt, createErr)
+		}
+
+		id, err := create(ctx)
+		if err == nil {
+			return EnsureResult{ID: id, Created: true}, nil
+		}
+		if !outcomeUnknown(err) {
+			return EnsureResult{}, fmt.Errorf("cloapi: ensure %s %q: %w", kind, name, err)
+		}
+		createErr = err
+
+		if ids, err = find(ctx); err != nil {
+			return EnsureResult{}, fmt.Errorf("cloapi: ensure %s %q: create failed (%w), then could not check whether it took effect: %w", kind, name, createErr, err)
+		}
+	}
+}
+
+// outcomeUnknown reports whether a failed create may nevertheless have created
+// the object: anything but a definite rejection by the API.
+func outcomeUnknown(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	apiErr, ok := AsApiError(err)
+	if !ok {
+		return true
+	}
+	return apiErr.Code >= 500 || apiErr.Code == http.StatusConflict
+}
+
+// idsNamed returns the IDs of the items whose name is name.
+func idsNamed[T any](items []T, name string, nameOf func(T) string, idOf func(T) string) []string {
+	var ids []string
+	for _, it := range items {
+		if nameOf(it) == name {
+			ids = append(ids, idOf(it))
+		}
+	}
+	return ids
+}
+
+// createdID extracts the ID from a create response, which may be empty when the
+// API sent no JSON body.
+func createdID(res *IdResponseSchema) (string, error) {
+	if res == nil || res.Id == "" {
+		return "", errors.New("cloapi: create response has no id")
+	}
+	return res.Id, nil
+}
+
+// nameFilter narrows list requests to one name. The API treats it as a hint
+// only; matches are always checked locally too. It has no exact-match
+// condition, and "in" splits its value at commas, so a name containing one is
+// not filtered on at all: every page is listed and matched locally.
+func nameFilter(name string) RequestEditorFn {
+	if strings.Contains(name, ",") {
+		return func(context.Context, *http.Request) error { return nil }
+	}
+	return WithFilter(FilterField{Field: "name", Condition: "in", Value: name})
+}
+
+// EnsureServer returns the server named body.Name in the project, creating it
+// from body only if there is none:
+//
+//	body, _ := spec.Build()
+//	res, err := cloapi.EnsureServer(ctx, cli, projectID, body)
+//	if res.Created { ... }
+//
+// An existing server is adopted as is; it is not compared with body.
+func EnsureServer(ctx context.Context, cli ClientWithResponsesInterface, projectID string, body ServerCreateJSONRequestBody) (EnsureResult, error) {
+	find := func(ctx context.Context) ([]string, error) {
+		servers, err := listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]ServerSchema, error) {
+			resp, err := cli.ProjectServerListWithResponse(ctx, projectID, nameFilter(body.Name), paging)
+			if err != nil || resp.OK == nil {
+				return 0, nil, err
+			}
+			return resp.OK.Count, resp.OK.Result, nil
+		})
+		return idsNamed(servers, body.Name, func(s ServerSchema) string { return s.Name }, func(s ServerSchema) string { return s.Id }), err
+	}
+	create := func(ctx context.Context) (string, error) {
+		resp, err := cli.ServerCreateWithResponse(ctx, projectID, body)
+		if err != nil {
+			return "", err
+		}
+		if resp.OK == nil {
+			return createdID(nil)
+		}
+		return createdID(resp.OK.Result)
+	}
+	return ensure(ctx, KindServer, body.Name, find, create)
+}
+
+// EnsureVolume returns the volume named body.Name in the project, creating it
+// from body only if there is none. body.Autorename must not be set, since a
+// renamed volume could never be found again.
+func EnsureVolume(ctx context.Context, cli ClientWithResponsesInterface, projectID string, body VolumeCreateJSONRequestBody) (EnsureResult, error) {
+	if body.Autorename != nil && *body.Autorename {
+		return EnsureResult{}, errors.New("cloapi: ensure volume: autorename defeats lookup by name")
+	}
+	find := func(ctx context.Context) ([]string, error) {
+		volumes, err := listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]VolumeSchema, error) {
+			resp, err := cli.ProjectVolumesListWithResponse(ctx, projectID, nameFilter(body.Name), paging)
+			if err != nil || resp.OK == nil {
+				return 0, nil, err
+			}
+			return resp.OK.Count, resp.OK.Result, nil
+		})
+		return idsNamed(volumes, body.Name, func(v VolumeSchema) string { return v.Name }, func(v VolumeSchema) string { return v.Id }), err
+	}
+	create := func(ctx context.Context) (string, error) {
+		resp, err := cli.VolumeCreateWithResponse(ctx, projectID, body)
+		if err != nil {
+			return "", err
+		}
+		if resp.OK == nil {
+			return createdID(nil)
+		}
+		return createdID(resp.OK.Result)
+	}
+	return ensure(ctx, KindVolume, body.Name, find, create)
+}
+
+// EnsureProject returns the project whose display name is *body.DisplayName,
+// creating it from body only if there is none.
+func EnsureProject(ctx context.Context, cli ClientWithResponsesInterface, body ProjectCreateJSONRequestBody) (EnsureResult, error) {
+	name := stringValue(body.DisplayName)
+	find := func(ctx context.Context) ([]string, error) {
+		projects, err := listProjects(ctx, cli)
+		return idsNamed(projects, name, func(p ProjectDetailSchema) string { return stringValue(p.DisplayName) }, func(p ProjectDetailSchema) string { return p.Id }), err
+	}
+	create := func(ctx context.Context) (string, error) {
+		resp, err := cli.ProjectCreateWithResponse(ctx, body)
+		if err != nil {
+			return "", err
+		}
+		if resp.OK == nil {
+			return createdID(nil)
+		}
+		return createdID(resp.OK.Result)
+	}
+	return ensure(ctx, KindProject, name, find, create)
+}
+
+// EnsureS3User returns the S3 user with canonical name body.CanonicalName in the
+// project, creating it from body only if there is none.
+func EnsureS3User(ctx context.Context, cli ClientWithResponsesInterface, projectID string, body S3UserCreateJSONRequestBody) (EnsureResult, error) {
+	find := func(ctx context.Context) ([]string, error) {
+		users, err := listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]S3UserSchema, error) {
+			resp, err := cli.S3UsersListWithResponse(ctx, projectID, paging)
+			if err != nil || resp.OK == nil {
+				return 0, nil, err
+			}
+			return resp.OK.Count, resp.OK.Result, nil
+		})
+		return idsNamed(users, body.CanonicalName, func(u S3UserSchema) string { return u.CanonicalName }, func(u S3UserSchema) string { return u.Id }), err
+	}
+	create := func(ctx context.Context) (string, error) {
+		resp, err := cli.S3UserCreateWithResponse(ctx, projectID, body)
+		if err != nil {
+			return "", err
+		}
+		if resp.OK == nil {
+			return createdID(nil)
+		}
+		return createdID(resp.OK.Result)
+	}
+	return ensure(ctx, KindS3User, body.CanonicalName, find, create)
+}
@@ -0,0 +1,172 @@
+package cloapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// ensureServer fakes the server list/create endpoints of project p1. failNext
+// makes the next creates fail with that status, after (lost) or before
+// (rejected) storing the server, per applyOnFail.
+type ensureServer struct {
+	mu          sync.Mutex
+	servers     []ServerSchema
+	creates     int
+	failNext    []int
+	applyOnFail bool
+}
+
+func (s *ensureServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/projects/p1/servers":
+		// name__in takes a comma-separated list, as the API's does.
+		servers := s.servers
+		if names := r.URL.Query().Get("name__in"); names != "" {
+			servers = slices.DeleteFunc(slices.Clone(servers), func(srv ServerSchema) bool {
+				return !slices.Contains(strings.Split(names, ","), srv.Name)
+			})
+		}
+		_ = json.NewEncoder(w).Encode(PagServersSchema{Count: len(servers), Result: &servers})
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/projects/p1/servers":
+		s.creates++
+		var body ServerCreateJSONRequestBody
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		id := fmt.Sprintf("srv-%d", s.creates)
+		if len(s.failNext) > 0 {
+			status := s.failNext[0]
+			s.failNext = s.failNext[1:]
+			if s.applyOnFail {
+				s.servers = append(s.servers, ServerSchema{Id: id, Name: body.Name})
+			}
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"message":"boom"}`))
+			return
+		}
+		s.servers = append(s.servers, ServerSchema{Id: id, Name: body.Name})
+		_ = json.NewEncoder(w).Encode(DetailedIdResponseSchemaD2b1f0f4{Result: &IdResponseSchema{Id: id}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newEnsureClient(t *testing.T, fake http.Handler) *ClientWithResponses {
+	t.Helper()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func TestEnsureServer(t *testing.T) {
+	ctx := context.Background()
+	body := ServerCreateJSONRequestBody{Name: "web-1"}
+
+	t.Run("creates when absent", func(t *testing.T) {
+		fake := &ensureServer{servers: []ServerSchema{{Id: "other", Name: "web-10"}}}
+		res, err := EnsureServer(ctx, newEnsureClient(t, fake), "p1", body)
+		if err != nil || !res.Created || res.ID != "srv-1" {
+			t.Fatalf("res = %+v, err = %v", res, err)
+		}
+	})
+
+	t.Run("adopts existing", func(t *testing.T) {
+		fake := &ensureServer{servers: []ServerSchema{{Id: "old", Name: "web-1"}}}
+		res, err := EnsureServer(ctx, newEnsureClient(t, fake), "p1", body)
+		if err != nil || res.Created || res.ID != "old" || fake.creates != 0 {
+			t.Fatalf("res = %+v, err = %v, creates = %d", res, err, fake.creates)
+		}
+	})
+
+	t.Run("adopts after a lost response", func(t *testing.T) {
+		fake := &ensureServer{failNext: []int{http.StatusBadGateway}, applyOnFail: true}
+		res, err := EnsureServer(ctx, newEnsureClient(t, fake), "p1", body)
+		if err != nil || !res.Created || res.ID != "srv-1" || fake.creates != 1 {
+			t.Fatalf("res = %+v, err = %v, creates = %d", res, err, fake.creates)
+		}
+	})
+
+	t.Run("retries a create that did not happen", func(t *testing.T) {
+		fake := &ensureServer{failNext: []int{http.StatusServiceUnavailable}}
+		res, err := EnsureServer(ctx, newEnsureClient(t, fake), "p1", body)
+		if err != nil || !res.Created || fake.creates != 2 || len(fake.servers) != 1 {
+			t.Fatalf("res = %+v, err = %v, creates = %d", res, err, fake.creates)
+		}
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		fake := &ensureServer{failNext: []int{503, 503, 503, 503}}
+		if _, err := EnsureServer(ctx, newEnsureClient(t, fake), "p1", body); err == nil || fake.creates != ensureAttempts {
+			t.Fatalf("err = %v, creates = %d", err, fake.creates)
+		}
+	})
+
+	t.Run("does not retry a rejection", func(t *testing.T) {
+		fake := &ensureServer{failNext: []int{http.StatusBadRequest}}
+		_, err := EnsureServer(ctx, newEnsureClient(t, fake), "p1", body)
+		if !HasStatus(err, http.StatusBadRequest) || fake.creates != 1 {
+			t.Fatalf("err = %v, creates = %d", err, fake.creates)
+		}
+	})
+
+	t.Run("adopts a name with a comma", func(t *testing.T) {
+		fake := &ensureServer{servers: []ServerSchema{{Id: "a", Name: "a"}, {Id: "old", Name: "a,b"}}}
+		res, err := EnsureServer(ctx, newEnsureClient(t, fake), "p1", ServerCreateJSONRequestBody{Name: "a,b"})
+		if err != nil || res.Created || res.ID != "old" || fake.creates != 0 {
+			t.Fatalf("res = %+v, err = %v, creates = %d", res, err, fake.creates)
+		}
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		fake := &ensureServer{servers: []ServerSchema{{Id: "a", Name: "web-1"}, {Id: "b", Name: "web-1"}}}
+		_, err := EnsureServer(ctx, newEnsureClient(t, fake), "p1", body)
+		var ambErr *AmbiguousNameError
+		if !errors.As(err, &ambErr) || len(ambErr.IDs) != 2 {
+			t.Fatalf("err = %v", err)
+		}
+	})
+}
+
+func TestEnsureProjectMatchesDisplayName(t *testing.T) {
+	var creates int
+	fake := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			creates++
+		}
+		display := "staging"
+		_ = json.NewEncoder(w).Encode(ProjectPagListSchema{Count: 1, Result: &[]ProjectDetailSchema{
+			{Id: "p-9", Name: "project-42", DisplayName: &display},
+		}})
+	})
+	name := "staging"
+	res, err := EnsureProject(context.Background(), newEnsureClient(t, fake), ProjectCreateJSONRequestBody{DisplayName: &name})
+	if err != nil || res.Created || res.ID != "p-9" || creates != 0 {
+		t.Fatalf("res = %+v, err = %v, creates = %d", res, err, creates)
+	}
+}
+
+func TestEnsureRejectsUnusableInput(t *testing.T) {
+	cli := newEnsureClient(t, http.NotFoundHandler())
+	ctx := context.Background()
+	yes := true
+	if _, err := EnsureVolume(ctx, cli, "p1", VolumeCreateJSONRequestBody{Name: "data", Autorename: &yes}); err == nil {
+		t.Error("want error for autorename")
+	}
+	if _, err := EnsureProject(ctx, cli, ProjectCreateJSONRequestBody{}); err == nil {
+		t.Error("want error for a project without display name")
+	}
+}
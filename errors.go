@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // Error makes the generated ApiError satisfy the error interface. The generated
@@ -57,3 +58,51 @@ func HasStatus(err error, status int) bool {
 	}
 	return false
 }
+
+// FieldError is a single locally detected problem with a request field.
+type FieldError struct {
+	// Field is the JSON path of the offending field, e.g. "storages[1].bootable",
+	// or, from ValidateRequestBody and WithRequestValidation, its JSON pointer,
+	// e.g. "/storages/1/bootable".
+	Field  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Reason
+}
+
+// ValidationError collects every FieldError found while validating a request
+// locally, so callers can fix them all at once instead of one 400 at a time. It
+// unwraps to the individual *FieldError values.
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return fmt.Sprintf("cloapi: invalid request: %s", strings.Join(msgs, "; "))
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Fields))
+	for i, f := range e.Fields {
+		errs[i] = f
+	}
+	return errs
+}
+
+func (e *ValidationError) add(field, reason string) {
+	e.Fields = append(e.Fields, &FieldError{Field: field, Reason: reason})
+}
+
+// errOrNil returns e as an error if it holds any field errors, else nil.
+func (e *ValidationError) errOrNil() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
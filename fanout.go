@@ -0,0 +1,86 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+)
+
+// ProjectItem is an item from a project-scoped list, tagged with its project.
+type ProjectItem[T any] struct {
+	ProjectID string
+	Item      T
+}
+
+// listProjects returns every project the token can see.
+func listProjects(ctx context.Context, cli ClientWithResponsesInterface) ([]ProjectDetailSchema, error) {
+	return listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]ProjectDetailSchema, error) {
+		resp, err := cli.ProjectListWithResponse(ctx, paging)
+		if err != nil || resp.OK == nil {
+			return 0, nil, err
+		}
+		return resp.OK.Count, resp.OK.Result, nil
+	})
+}
+
+// ForEachProject pages through the project list and calls fn for every project,
+// with the concurrency and failure policy of opts (see Batch). Failures are
+// returned as a *BatchError keyed by project ID; a failure to list the projects
+// themselves is returned as is.
+func ForEachProject(ctx context.Context, cli ClientWithResponsesInterface, fn func(ctx context.Context, project ProjectDetailSchema) error, opts BatchOptions) error {
+	projects, err := listProjects(ctx, cli)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]ProjectDetailSchema, len(projects))
+	ids := make([]string, len(projects))
+	for i, p := range projects {
+		byID[p.Id], ids[i] = p, p.Id
+	}
+	_, err = Batch(ctx, ids, func(ctx context.Context, id string) (struct{}, error) {
+		return struct{}{}, fn(ctx, byID[id])
+	}, opts)
+	return err
+}
+
+// ListAcrossProjects drains a project-scoped list endpoint in every project and
+// returns the items tagged with their project ID. page has the shape of a
+// Paginator fetch with the project ID added:
+//
+//	servers, err := cloapi.ListAcrossProjects(ctx, cli,
+//		func(ctx context.Context, projectID string, paging cloapi.RequestEditorFn) (int, *[]cloapi.ServerSchema, error) {
+//			resp, err := cli.ProjectServerListWithResponse(ctx, projectID, paging)
+//			if err != nil || resp.OK == nil {
+//				return 0, nil, err
+//			}
+//			return resp.OK.Count, resp.OK.Result, nil
+//		}, cloapi.BatchOptions{Concurrency: 4})
+//
+// When some projects fail, the items of the others are still returned, together
+// with a *BatchError naming the failed projects.
+func ListAcrossProjects[T any](ctx context.Context, cli ClientWithResponsesInterface, page func(ctx context.Context, projectID string, paging RequestEditorFn) (int, *[]T, error), opts BatchOptions) ([]ProjectItem[T], error) {
+	projects, err := listProjects(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(projects))
+	for i, p := range projects {
+		ids[i] = p.Id
+	}
+	results, err := Batch(ctx, ids, func(ctx context.Context, projectID string) ([]T, error) {
+		return listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]T, error) {
+			return page(ctx, projectID, paging)
+		})
+	}, opts)
+
+	var batchErr *BatchError
+	if err != nil && !errors.As(err, &batchErr) {
+		return nil, err
+	}
+	var items []ProjectItem[T]
+	for _, r := range results {
+		for _, it := range r.Value {
+			items = append(items, ProjectItem[T]{ProjectID: r.ID, Item: it})
+		}
+	}
+	return items, err
+}
@@ -0,0 +1,89 @@
+package cloapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newFanoutClient serves three projects; p2's server list fails with a 500.
+func newFanoutClient(t *testing.T) *ClientWithResponses {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v2/projects":
+			_ = json.NewEncoder(w).Encode(ProjectPagListSchema{Count: 3, Result: &[]ProjectDetailSchema{{Id: "p1"}, {Id: "p2"}, {Id: "p3"}}})
+		case r.URL.Path == "/v2/projects/p2/servers":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"message":"down"}`))
+		case strings.HasSuffix(r.URL.Path, "/servers"):
+			project := strings.Split(r.URL.Path, "/")[3]
+			servers := []ServerSchema{{Id: project + "-a"}, {Id: project + "-b"}}
+			_ = json.NewEncoder(w).Encode(PagServersSchema{Count: 2, Result: &servers})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func TestListAcrossProjects(t *testing.T) {
+	cli := newFanoutClient(t)
+	items, err := ListAcrossProjects(context.Background(), cli,
+		func(ctx context.Context, projectID string, paging RequestEditorFn) (int, *[]ServerSchema, error) {
+			resp, err := cli.ProjectServerListWithResponse(ctx, projectID, paging)
+			if err != nil || resp.OK == nil {
+				return 0, nil, err
+			}
+			return resp.OK.Count, resp.OK.Result, nil
+		}, BatchOptions{Concurrency: 2})
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Items) != 1 || batchErr.Items[0].ID != "p2" || !IsServerError(err) {
+		t.Fatalf("err = %v, want p2 reported as failed", err)
+	}
+	var got []string
+	for _, it := range items {
+		if !strings.HasPrefix(it.Item.Id, it.ProjectID+"-") {
+			t.Errorf("item %s tagged with project %s", it.Item.Id, it.ProjectID)
+		}
+		got = append(got, it.Item.Id)
+	}
+	if strings.Join(got, ",") != "p1-a,p1-b,p3-a,p3-b" {
+		t.Errorf("items = %v", got)
+	}
+}
+
+func TestForEachProject(t *testing.T) {
+	var mu sync.Mutex
+	var seen []string
+	err := ForEachProject(context.Background(), newFanoutClient(t), func(ctx context.Context, p ProjectDetailSchema) error {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, p.Id)
+		if p.Id == "p3" {
+			return errors.New("skip me")
+		}
+		return nil
+	}, BatchOptions{})
+	sort.Strings(seen)
+	if strings.Join(seen, ",") != "p1,p2,p3" {
+		t.Errorf("seen = %v", seen)
+	}
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || batchErr.Err("p3") == nil || batchErr.Err("p1") != nil {
+		t.Fatalf("err = %v", err)
+	}
+}
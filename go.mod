@@ -1,8 +1,16 @@
 module github.com/clo-ru/cloapi-go-client/v3
 
-go 1.25
+go 1.25.0
 
-require github.com/oapi-codegen/runtime v1.4.1
+require (
+	github.com/andybalholm/brotli v1.2.0
+	github.com/goccy/go-json v0.10.2
+	github.com/oapi-codegen/runtime v1.4.1
+	golang.org/x/crypto v0.54.0
+	golang.org/x/sync v0.22.0
+	golang.org/x/time v0.15.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
 	github.com/apapsch/go-jsonmerge/v2 v2.0.0 // indirect
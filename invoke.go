@@ -0,0 +1,134 @@
+package cloapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Invoke calls the operation named by operationID without compile-time types,
+// for scripting:
+//
+//	out, err := cli.Invoke(ctx, "ServerReboot", map[string]string{"object_id": id}, nil)
+//
+// It knows the operations of this build only (see OperationByID); for endpoints
+// newer than the build use InvokeOperation.
+//
+// pathParams must name exactly the operation's path parameters (see
+// Operation.PathParams). body is sent as JSON: a json.RawMessage or []byte as
+// is, anything else encoded with the JSON codec; nil sends no body. The request
+// goes through the client's editors and transports like any generated call.
+//
+// The success body is returned undecoded, nil if empty. A non-2xx status is
+// returned as *ApiError.
+func (c *ClientWithResponses) Invoke(ctx context.Context, operationID string, pathParams map[string]string, body any, reqEditors ...RequestEditorFn) (json.RawMessage, error) {
+	op, ok := OperationByID(operationID)
+	if !ok {
+		return nil, fmt.Errorf("cloapi: invoke: unknown operation %q", operationID)
+	}
+	return c.InvokeOperation(ctx, op, pathParams, body, reqEditors...)
+}
+
+// InvokeOperation calls op like Invoke. op need not be compiled in: it may come
+// from OperationsFromSpec on a spec loaded at run time, or be written out, so
+// tools reach endpoints added after they were built:
+//
+//	op := cloapi.Operation{Method: "POST", Path: "/v2/widgets/{object_id}/refresh", PathParams: []string{"object_id"}}
+//	out, err := cli.InvokeOperation(ctx, op, map[string]string{"object_id": id}, nil)
+func (c *ClientWithResponses) InvokeOperation(ctx context.Context, op Operation, pathParams map[string]string, body any, reqEditors ...RequestEditorFn) (json.RawMessage, error) {
+	client, ok := c.ClientInterface.(*Client)
+	if !ok {
+		return nil, errors.New("cloapi: invoke: client was not built by NewClient")
+	}
+	req, err := newInvokeRequest(ctx, client.Server, op, pathParams, body)
+	if err != nil {
+		name := op.ID
+		if name == "" {
+			name = op.Method + " " + op.Path
+		}
+		return nil, fmt.Errorf("cloapi: invoke %s: %w", name, err)
+	}
+	if err := client.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+
+	rsp, err := client.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rsp.Body.Close() }()
+	data, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode >= 400 {
+		return nil, apiErrorOf(rsp, data)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return json.RawMessage(data), nil
+}
+
+// newInvokeRequest builds the request of op the way the generated New*Request
+// functions do.
+func newInvokeRequest(ctx context.Context, server string, op Operation, pathParams map[string]string, body any) (*http.Request, error) {
+	for name := range pathParams {
+		if !slices.Contains(op.PathParams, name) {
+			return nil, fmt.Errorf("unknown path parameter %q, want %v", name, op.PathParams)
+		}
+	}
+	path := op.Path
+	for _, name := range op.PathParams {
+		value, ok := pathParams[name]
+		if !ok {
+			return nil, fmt.Errorf("missing path parameter %q", name)
+		}
+		encoded, err := runtime.StyleParamWithLocation("simple", false, name, runtime.ParamLocationPath, value)
+		if err != nil {
+			return nil, err
+		}
+		path = strings.Replace(path, "{"+name+"}", encoded, 1)
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+	queryURL, err := serverURL.Parse("." + path)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyReader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case json.RawMessage:
+		bodyReader = bytes.NewReader(b)
+	case []byte:
+		bodyReader = bytes.NewReader(b)
+	default:
+		buf, err := jsonCodec.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(buf)
+	}
+	req, err := http.NewRequestWithContext(ctx, op.Method, queryURL.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	if bodyReader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
@@ -0,0 +1,137 @@
+package cloapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type invokeCall struct {
+	method, path, auth, contentType, body string
+}
+
+func invokeServer(t *testing.T, status int, reply string) (*ClientWithResponses, *invokeCall) {
+	t.Helper()
+	got := &invokeCall{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		*got = invokeCall{r.Method, r.URL.EscapedPath(), r.Header.Get("Authorization"), r.Header.Get("Content-Type"), string(b)}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, reply)
+	}))
+	t.Cleanup(srv.Close)
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli, got
+}
+
+func TestInvoke(t *testing.T) {
+	cli, got := invokeServer(t, http.StatusOK, `{"result":{"id":"s1"}}`)
+	out, err := cli.Invoke(context.Background(), "ServerCreate", map[string]string{"object_id": "p1"},
+		map[string]any{"name": "web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.method != http.MethodPost || got.path != "/v2/projects/p1/servers" || got.auth != "Bearer tok" ||
+		got.contentType != "application/json" || got.body != `{"name":"web"}` {
+		t.Errorf("request = %+v", got)
+	}
+	var res struct {
+		Result IdResponseSchema `json:"result"`
+	}
+	if err := json.Unmarshal(out, &res); err != nil || res.Result.Id != "s1" {
+		t.Errorf("out = %s, err = %v", out, err)
+	}
+}
+
+func TestInvokeNoBody(t *testing.T) {
+	cli, got := invokeServer(t, http.StatusNoContent, "")
+	out, err := cli.Invoke(context.Background(), "ServerReboot", map[string]string{"object_id": "a/b"}, nil)
+	if err != nil || out != nil {
+		t.Fatalf("out = %q, err = %v", out, err)
+	}
+	if got.method != http.MethodPost || got.path != "/v2/servers/a%2Fb/reboot" || got.contentType != "" {
+		t.Errorf("request = %+v", got)
+	}
+}
+
+func TestInvokeRawBodyAndEditors(t *testing.T) {
+	cli, got := invokeServer(t, http.StatusOK, `{"count":0,"result":[]}`)
+	_, err := cli.Invoke(context.Background(), "ProjectServerList", map[string]string{"object_id": "p1"}, nil, WithPage(10, 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.method != http.MethodGet {
+		t.Errorf("method = %s", got.method)
+	}
+
+	_, err = cli.Invoke(context.Background(), "ServerCreate", map[string]string{"object_id": "p1"}, json.RawMessage(`{"raw":true}`))
+	if err != nil || got.body != `{"raw":true}` {
+		t.Errorf("body = %q, err = %v", got.body, err)
+	}
+}
+
+func TestInvokeErrors(t *testing.T) {
+	cli, _ := invokeServer(t, http.StatusNotFound, `{"code":404,"message":"no server"}`)
+	ctx := context.Background()
+
+	if _, err := cli.Invoke(ctx, "ServerReboot", map[string]string{"object_id": "s1"}, nil); !IsNotFound(err) {
+		t.Errorf("err = %v, want ApiError 404", err)
+	}
+	for _, tc := range []struct {
+		op     string
+		params map[string]string
+		want   string
+	}{
+		{"NoSuchOperation", nil, "unknown operation"},
+		{"ServerReboot", nil, `missing path parameter "object_id"`},
+		{"ServerReboot", map[string]string{"object_id": "s1", "id": "x"}, `unknown path parameter "id"`},
+	} {
+		if _, err := cli.Invoke(ctx, tc.op, tc.params, nil); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("Invoke(%s, %v) err = %v, want %q", tc.op, tc.params, err, tc.want)
+		}
+	}
+}
+
+func TestInvokeOperationFromSpec(t *testing.T) {
+	ops, err := OperationsFromSpec([]byte(`{"paths": {
+		"/v2/widgets/{object_id}/refresh": {"parameters": [], "post": {"operationId": "WidgetRefresh", "tags": ["widgets"]}},
+		"/v2/projects/{object_id}/widgets": {"get": {"operationId": "WidgetList", "responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pag"}}}}}}}
+	}, "components": {"schemas": {"Pag": {"properties": {"count": {}, "result": {}}}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 2 || ops[0].ID != "WidgetList" || !ops[0].List || ops[1].ID != "WidgetRefresh" || ops[1].List ||
+		!slices.Equal(ops[1].PathParams, []string{"object_id"}) || ops[1].Method != http.MethodPost ||
+		!slices.Equal(ops[1].Tags, []string{"widgets"}) {
+		t.Fatalf("ops = %+v", ops)
+	}
+	if _, ok := OperationByID("WidgetRefresh"); ok {
+		t.Fatal("test operation is compiled in")
+	}
+
+	cli, got := invokeServer(t, http.StatusOK, `{"result":{}}`)
+	out, err := cli.InvokeOperation(context.Background(), ops[1], map[string]string{"object_id": "s1"}, map[string]any{"password": "x"})
+	if err != nil || string(out) != `{"result":{}}` {
+		t.Fatalf("out = %s, err = %v", out, err)
+	}
+	if got.method != http.MethodPost || got.path != "/v2/widgets/s1/refresh" || got.body != `{"password":"x"}` {
+		t.Errorf("request = %+v", got)
+	}
+
+	op := Operation{Method: http.MethodGet, Path: "/v2/things/{id}", PathParams: []string{"id"}}
+	if _, err := cli.InvokeOperation(context.Background(), op, nil, nil); err == nil || !strings.Contains(err.Error(), "invoke GET /v2/things/{id}: missing path parameter") {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := OperationsFromSpec([]byte("not json")); err == nil {
+		t.Error("invalid spec accepted")
+	}
+}
@@ -0,0 +1,184 @@
+package cloapi
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// KeyType selects the algorithm of a locally generated SSH key.
+type KeyType string
+
+const (
+	KeyTypeEd25519 KeyType = "ed25519"
+	KeyTypeRSA     KeyType = "rsa"
+)
+
+// defaultRSABits is the RSA key size used when none is given.
+const defaultRSABits = 4096
+
+// LocalKeypair is an SSH key generated on this machine. Unlike
+// GenerateKeypairWithResponse, which generates the key server-side and returns the
+// private half over the wire, only the public half ever leaves the process.
+type LocalKeypair struct {
+	PrivateKey crypto.Signer
+	PublicKey  ssh.PublicKey
+	// Comment is appended to the authorized_keys line and stored in the private key.
+	Comment string
+}
+
+// GenerateLocalKeypair creates a new key. bits applies to RSA only; 0 means 4096.
+func GenerateLocalKeypair(keyType KeyType, bits int, comment string) (*LocalKeypair, error) {
+	var priv crypto.Signer
+	switch keyType {
+	case KeyTypeEd25519, "":
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		priv = k
+	case KeyTypeRSA:
+		if bits == 0 {
+			bits = defaultRSABits
+		}
+		if bits < 2048 {
+			return nil, fmt.Errorf("cloapi: RSA keys need at least 2048 bits, got %d", bits)
+		}
+		k, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, err
+		}
+		priv = k
+	default:
+		return nil, fmt.Errorf("cloapi: unsupported key type %q", keyType)
+	}
+
+	pub, err := ssh.NewPublicKey(priv.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &LocalKeypair{PrivateKey: priv, PublicKey: pub, Comment: comment}, nil
+}
+
+// AuthorizedKey returns the public key as an authorized_keys line.
+func (k *LocalKeypair) AuthorizedKey() string {
+	line := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(k.PublicKey)))
+	if k.Comment != "" {
+		line += " " + k.Comment
+	}
+	return line
+}
+
+// Fingerprint returns the SHA256 fingerprint of the public key, as ssh-keygen -l
+// prints it.
+func (k *LocalKeypair) Fingerprint() string {
+	return ssh.FingerprintSHA256(k.PublicKey)
+}
+
+// WritePrivateKey writes the private key to w in OpenSSH PEM format.
+func (k *LocalKeypair) WritePrivateKey(w io.Writer) error {
+	block, err := ssh.MarshalPrivateKey(k.PrivateKey, k.Comment)
+	if err != nil {
+		return err
+	}
+	return pem.Encode(w, block)
+}
+
+// WritePrivateKeyFile writes the private key to a new file with 0600 permissions.
+// It refuses to overwrite an existing file.
+func (k *LocalKeypair) WritePrivateKeyFile(path string) (err error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+	return k.WritePrivateKey(f)
+}
+
+// KeyFingerprint parses an authorized_keys line and returns its SHA256
+// fingerprint. Comments and options do not affect the result, so it identifies a
+// key regardless of how it was labelled.
+func KeyFingerprint(authorizedKey string) (string, error) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(authorizedKey))
+	if err != nil {
+		return "", fmt.Errorf("cloapi: parse public key: %w", err)
+	}
+	return ssh.FingerprintSHA256(pub), nil
+}
+
+// listKeypairs returns every keypair of a project.
+func listKeypairs(ctx context.Context, cli ClientWithResponsesInterface, projectID string) ([]KeyPairSchema, error) {
+	return listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]KeyPairSchema, error) {
+		resp, err := cli.KeyPairsListWithResponse(ctx, projectID, paging)
+		if err != nil || resp.OK == nil {
+			return 0, nil, err
+		}
+		return resp.OK.Count, resp.OK.Result, nil
+	})
+}
+
+// ImportPublicKey imports an authorized_keys line under name, unless the project
+// already holds a keypair with the same fingerprint, in which case that keypair is
+// returned with existing set.
+func ImportPublicKey(ctx context.Context, cli ClientWithResponsesInterface, projectID, name, authorizedKey string) (kp KeyPairSchema, existing bool, err error) {
+	fp, err := KeyFingerprint(authorizedKey)
+	if err != nil {
+		return kp, false, err
+	}
+	keys, err := listKeypairs(ctx, cli, projectID)
+	if err != nil {
+		return kp, false, err
+	}
+	for _, k := range keys {
+		// Keys the API holds but this package cannot parse simply never match.
+		if kfp, err := KeyFingerprint(k.PublicKey); err == nil && kfp == fp {
+			return k, true, nil
+		}
+	}
+
+	resp, err := cli.ImportKeypairWithResponse(ctx, projectID, ImportKeypairJSONRequestBody{Name: name, PublicKey: authorizedKey})
+	if err != nil {
+		return kp, false, err
+	}
+	if resp.OK == nil || resp.OK.Result == nil {
+		return kp, false, errors.New("cloapi: import keypair: empty response")
+	}
+	return *resp.OK.Result, false, nil
+}
+
+// CreateLocalKeypair generates a key locally, writes its private half to
+// privateKey, then imports only the public half into the project under name:
+//
+//	f, _ := os.OpenFile("id_ci", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+//	kp, err := cloapi.CreateLocalKeypair(ctx, cli, projectID, "ci-key", cloapi.KeyTypeEd25519, f)
+//
+// The private key is written first, so an import failure never leaves a key in
+// the project whose private half was lost.
+func CreateLocalKeypair(ctx context.Context, cli ClientWithResponsesInterface, projectID, name string, keyType KeyType, privateKey io.Writer) (KeyPairSchema, error) {
+	key, err := GenerateLocalKeypair(keyType, 0, name)
+	if err != nil {
+		return KeyPairSchema{}, err
+	}
+	if err := key.WritePrivateKey(privateKey); err != nil {
+		return KeyPairSchema{}, fmt.Errorf("cloapi: write private key: %w", err)
+	}
+	kp, _, err := ImportPublicKey(ctx, cli, projectID, name, key.AuthorizedKey())
+	return kp, err
+}
@@ -0,0 +1,166 @@
+package cloapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestGenerateLocalKeypair(t *testing.T) {
+	for _, kt := range []KeyType{KeyTypeEd25519, KeyTypeRSA} {
+		t.Run(string(kt), func(t *testing.T) {
+			bits := 0
+			if kt == KeyTypeRSA {
+				bits = 2048 // keep the test fast
+			}
+			key, err := GenerateLocalKeypair(kt, bits, "me@host")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var pemBuf bytes.Buffer
+			if err := key.WritePrivateKey(&pemBuf); err != nil {
+				t.Fatal(err)
+			}
+			signer, err := ssh.ParsePrivateKey(pemBuf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ssh.FingerprintSHA256(signer.PublicKey()) != key.Fingerprint() {
+				t.Error("private key does not match public key")
+			}
+			if !strings.HasSuffix(key.AuthorizedKey(), " me@host") {
+				t.Errorf("authorized key = %q", key.AuthorizedKey())
+			}
+		})
+	}
+	if _, err := GenerateLocalKeypair(KeyTypeRSA, 1024, ""); err == nil {
+		t.Error("want error for a weak RSA key")
+	}
+}
+
+func TestWritePrivateKeyFile(t *testing.T) {
+	key, err := GenerateLocalKeypair(KeyTypeEd25519, 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "id_ed25519")
+	if err := key.WritePrivateKeyFile(path); err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0o600 {
+		t.Errorf("mode = %v, want 0600", fi.Mode().Perm())
+	}
+	if err := key.WritePrivateKeyFile(path); err == nil {
+		t.Error("want error when the file exists")
+	}
+}
+
+func TestKeyFingerprintIgnoresComment(t *testing.T) {
+	key, err := GenerateLocalKeypair(KeyTypeEd25519, 0, "old comment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	relabelled := strings.TrimSuffix(key.AuthorizedKey(), "old comment") + "new comment"
+	fp, err := KeyFingerprint(relabelled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fp != key.Fingerprint() {
+		t.Errorf("fingerprint changed with the comment")
+	}
+}
+
+// keypairServer fakes the keypair list/import/delete endpoints of project p1.
+type keypairServer struct {
+	mu      sync.Mutex
+	keys    []KeyPairSchema
+	deleted []string
+	reject  string // a public key whose import fails
+}
+
+func (s *keypairServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/projects/p1/keypairs":
+		_ = json.NewEncoder(w).Encode(PagKeyPairSchema{Count: len(s.keys), Result: &s.keys})
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/projects/p1/keypairs":
+		var body ImportKeypairJSONRequestBody
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, k := range s.keys {
+			if k.Name == body.Name {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"code":409,"message":"keypair with this name already exists"}`))
+				return
+			}
+		}
+		if body.PublicKey == s.reject {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":400,"message":"invalid public key"}`))
+			return
+		}
+		kp := KeyPairSchema{Id: "new-" + body.Name, Name: body.Name, PublicKey: body.PublicKey}
+		s.keys = append(s.keys, kp)
+		_ = json.NewEncoder(w).Encode(DetailedKeyPairSchema608d05d6{Result: &kp})
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v2/keypairs/"):
+		id := strings.TrimPrefix(r.URL.Path, "/v2/keypairs/")
+		s.deleted = append(s.deleted, id)
+		for i, k := range s.keys {
+			if k.Id == id {
+				s.keys = append(s.keys[:i], s.keys[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newKeypairClient(t *testing.T, fake *keypairServer) *ClientWithResponses {
+	t.Helper()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func TestCreateLocalKeypairAndDedupe(t *testing.T) {
+	fake := &keypairServer{}
+	cli := newKeypairClient(t, fake)
+	ctx := context.Background()
+
+	var priv bytes.Buffer
+	kp, err := CreateLocalKeypair(ctx, cli, "p1", "ci-key", KeyTypeEd25519, &priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kp.Id != "new-ci-key" || !strings.Contains(priv.String(), "OPENSSH PRIVATE KEY") {
+		t.Fatalf("kp = %+v, private key written = %v", kp, priv.Len() > 0)
+	}
+
+	again, existing, err := ImportPublicKey(ctx, cli, "p1", "other-name", kp.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !existing || again.Id != kp.Id || len(fake.keys) != 1 {
+		t.Fatalf("want existing keypair reused, got %+v existing=%v (%d keys)", again, existing, len(fake.keys))
+	}
+}
@@ -0,0 +1,167 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// DesiredKey is a public key that should exist in a project, e.g. one line of a
+// team's authorized_keys file kept in git.
+type DesiredKey struct {
+	Name      string
+	PublicKey string
+}
+
+// KeySyncPlan is what SyncKeypairs does (or, in a dry run, would do) to make a
+// project's keypairs match the desired set.
+type KeySyncPlan struct {
+	// Import are desired keys the project lacks.
+	Import []DesiredKey
+	// Delete are project keypairs matching no desired key.
+	Delete []KeyPairSchema
+	// Keep are project keypairs already matching a desired key, or protected.
+	Keep []KeyPairSchema
+	// Unparseable are project keypairs whose public key could not be parsed.
+	// They are left alone, since they cannot be matched against desired keys.
+	Unparseable []KeyPairSchema
+}
+
+// KeySyncOptions tunes SyncKeypairs.
+type KeySyncOptions struct {
+	// DryRun computes the plan without changing anything.
+	DryRun bool
+	// Protect lists keypairs that are never deleted, by name, ID or fingerprint.
+	Protect []string
+}
+
+// PlanKeySync compares desired with the project's keypairs. Keys are matched by
+// fingerprint, not name or comment, so relabelling a key causes no churn.
+func PlanKeySync(ctx context.Context, cli ClientWithResponsesInterface, projectID string, desired []DesiredKey, protect []string) (*KeySyncPlan, error) {
+	want := make(map[string]DesiredKey, len(desired))
+	var order []string
+	for _, d := range desired {
+		fp, err := KeyFingerprint(d.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("cloapi: desired key %q: %w", d.Name, err)
+		}
+		if prev, ok := want[fp]; ok {
+			return nil, fmt.Errorf("cloapi: desired keys %q and %q are the same key", prev.Name, d.Name)
+		}
+		want[fp] = d
+		order = append(order, fp)
+	}
+	protected := make(map[string]bool, len(protect))
+	for _, p := range protect {
+		protected[p] = true
+	}
+
+	current, err := listKeypairs(ctx, cli, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &KeySyncPlan{}
+	have := map[string]bool{}
+	for _, k := range current {
+		fp, err := KeyFingerprint(k.PublicKey)
+		_, wanted := want[fp]
+		switch {
+		case err == nil && wanted && !have[fp]:
+			have[fp] = true
+			plan.Keep = append(plan.Keep, k)
+		case protected[k.Name] || protected[k.Id] || (err == nil && protected[fp]):
+			plan.Keep = append(plan.Keep, k)
+		case err != nil:
+			plan.Unparseable = append(plan.Unparseable, k)
+		default:
+			// Duplicates of an already kept key are stale too.
+			plan.Delete = append(plan.Delete, k)
+		}
+	}
+	for _, fp := range order {
+		if !have[fp] {
+			plan.Import = append(plan.Import, want[fp])
+		}
+	}
+	return plan, nil
+}
+
+// Apply imports and deletes per the plan, importing first so that a failed
+// import never leaves a name without a key. A stale keypair sharing its name
+// with an imported key, as when a key is rotated under the same name, is only
+// deleted once its replacement is in, and kept if the import fails. Should the
+// API refuse the import because the name is taken, the stale keypair is deleted
+// to free it and the import retried; if that fails too, the stale key is
+// imported back. Apply carries on past failures and returns them joined, so
+// one bad key does not block the rest.
+func (p *KeySyncPlan) Apply(ctx context.Context, cli ClientWithResponsesInterface, projectID string) error {
+	superseded := map[string][]KeyPairSchema{}
+	for _, d := range p.Import {
+		superseded[d.Name] = nil
+	}
+	for _, k := range p.Delete {
+		if old, ok := superseded[k.Name]; ok {
+			superseded[k.Name] = append(old, k)
+		}
+	}
+
+	var errs []error
+	remove := func(k KeyPairSchema) bool {
+		if _, err := cli.KeypairDeleteWithResponse(ctx, k.Id); err != nil && !IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("delete %s (%s): %w", k.Name, k.Id, err))
+			return false
+		}
+		return true
+	}
+	importKey := func(name, publicKey string) error {
+		_, err := cli.ImportKeypairWithResponse(ctx, projectID, ImportKeypairJSONRequestBody{Name: name, PublicKey: publicKey})
+		return err
+	}
+
+	// Names whose stale keypairs are dealt with during the imports.
+	settled := map[string]bool{}
+	for _, d := range p.Import {
+		err := importKey(d.Name, d.PublicKey)
+		if old := superseded[d.Name]; HasStatus(err, http.StatusConflict) && len(old) > 0 {
+			settled[d.Name] = true
+			freed := true
+			for _, k := range old {
+				freed = remove(k) && freed
+			}
+			if freed {
+				if err = importKey(d.Name, d.PublicKey); err != nil {
+					for _, k := range old {
+						if rerr := importKey(k.Name, k.PublicKey); rerr != nil {
+							errs = append(errs, fmt.Errorf("restore %s: %w", k.Name, rerr))
+						}
+					}
+				}
+			}
+		}
+		if err != nil {
+			settled[d.Name] = true // keep whatever old key is still there
+			errs = append(errs, fmt.Errorf("import %s: %w", d.Name, err))
+		}
+	}
+	for _, k := range p.Delete {
+		if !settled[k.Name] {
+			remove(k)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SyncKeypairs reconciles the project's keypairs with desired and returns the
+// plan it carried out; with opts.DryRun it only returns the plan.
+//
+//	plan, err := cloapi.SyncKeypairs(ctx, cli, projectID, desired,
+//		cloapi.KeySyncOptions{DryRun: true, Protect: []string{"break-glass"}})
+func SyncKeypairs(ctx context.Context, cli ClientWithResponsesInterface, projectID string, desired []DesiredKey, opts KeySyncOptions) (*KeySyncPlan, error) {
+	plan, err := PlanKeySync(ctx, cli, projectID, desired, opts.Protect)
+	if err != nil || opts.DryRun {
+		return plan, err
+	}
+	return plan, plan.Apply(ctx, cli, projectID)
+}
@@ -0,0 +1,125 @@
+package cloapi
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func mustKey(t *testing.T, comment string) *LocalKeypair {
+	t.Helper()
+	k, err := GenerateLocalKeypair(KeyTypeEd25519, 0, comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func TestSyncKeypairs(t *testing.T) {
+	alice, bob, carol, admin := mustKey(t, "alice@old"), mustKey(t, "bob"), mustKey(t, "carol"), mustKey(t, "admin")
+	fake := &keypairServer{keys: []KeyPairSchema{
+		{Id: "k-alice", Name: "alice-laptop", PublicKey: alice.AuthorizedKey()},
+		{Id: "k-carol", Name: "carol", PublicKey: carol.AuthorizedKey()},
+		{Id: "k-admin", Name: "break-glass", PublicKey: admin.AuthorizedKey()},
+	}}
+	cli := newKeypairClient(t, fake)
+	ctx := context.Background()
+
+	// Alice's comment changed: still the same key, so it is kept, not re-imported.
+	relabelled := strings.TrimSuffix(alice.AuthorizedKey(), "alice@old") + "alice@new"
+	desired := []DesiredKey{{Name: "alice", PublicKey: relabelled}, {Name: "bob", PublicKey: bob.AuthorizedKey()}}
+	opts := KeySyncOptions{DryRun: true, Protect: []string{"break-glass"}}
+
+	plan, err := SyncKeypairs(ctx, cli, "p1", desired, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Import) != 1 || plan.Import[0].Name != "bob" {
+		t.Errorf("Import = %+v, want bob", plan.Import)
+	}
+	if len(plan.Delete) != 1 || plan.Delete[0].Id != "k-carol" {
+		t.Errorf("Delete = %+v, want k-carol", plan.Delete)
+	}
+	if len(plan.Keep) != 2 {
+		t.Errorf("Keep = %+v, want alice and break-glass", plan.Keep)
+	}
+	if len(fake.keys) != 3 || len(fake.deleted) != 0 {
+		t.Fatal("dry run changed the project")
+	}
+
+	opts.DryRun = false
+	if _, err := SyncKeypairs(ctx, cli, "p1", desired, opts); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fake.deleted, []string{"k-carol"}) {
+		t.Errorf("deleted = %v", fake.deleted)
+	}
+	var names []string
+	for _, k := range fake.keys {
+		names = append(names, k.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"alice-laptop", "break-glass", "bob"}) {
+		t.Errorf("keys after sync = %v", names)
+	}
+
+	plan, err = PlanKeySync(ctx, cli, "p1", desired, opts.Protect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Import)+len(plan.Delete) != 0 {
+		t.Errorf("second sync should be a no-op, got %+v", plan)
+	}
+}
+
+func TestPlanKeySyncRejectsDuplicateDesired(t *testing.T) {
+	k := mustKey(t, "")
+	cli := newKeypairClient(t, &keypairServer{})
+	_, err := PlanKeySync(context.Background(), cli, "p1",
+		[]DesiredKey{{Name: "a", PublicKey: k.AuthorizedKey()}, {Name: "b", PublicKey: k.AuthorizedKey() + " other"}}, nil)
+	if err == nil {
+		t.Fatal("want error for the same key listed twice")
+	}
+}
+
+func TestSyncKeypairsRotatesUnderSameName(t *testing.T) {
+	old, rotated := mustKey(t, "alice"), mustKey(t, "alice")
+	fake := &keypairServer{keys: []KeyPairSchema{
+		{Id: "k-alice", Name: "alice", PublicKey: old.AuthorizedKey()},
+		{Id: "k-legacy", Name: "legacy", PublicKey: "ssh-rsa not-base64"},
+	}}
+	cli := newKeypairClient(t, fake)
+
+	plan, err := SyncKeypairs(context.Background(), cli, "p1", []DesiredKey{{Name: "alice", PublicKey: rotated.AuthorizedKey()}}, KeySyncOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Unparseable) != 1 || plan.Unparseable[0].Id != "k-legacy" {
+		t.Errorf("Unparseable = %+v, want k-legacy", plan.Unparseable)
+	}
+	if !reflect.DeepEqual(fake.deleted, []string{"k-alice"}) {
+		t.Errorf("deleted = %v, want only the rotated key", fake.deleted)
+	}
+	var got []string
+	for _, k := range fake.keys {
+		got = append(got, k.Id)
+	}
+	if !reflect.DeepEqual(got, []string{"k-legacy", "new-alice"}) {
+		t.Errorf("keys after sync = %v", got)
+	}
+}
+
+func TestSyncKeypairsKeepsOldKeyWhenImportFails(t *testing.T) {
+	old, rotated := mustKey(t, "alice"), mustKey(t, "alice")
+	fake := &keypairServer{keys: []KeyPairSchema{{Id: "k-alice", Name: "alice", PublicKey: old.AuthorizedKey()}}, reject: rotated.AuthorizedKey()}
+	cli := newKeypairClient(t, fake)
+
+	_, err := SyncKeypairs(context.Background(), cli, "p1", []DesiredKey{{Name: "alice", PublicKey: rotated.AuthorizedKey()}}, KeySyncOptions{})
+	if err == nil || !strings.Contains(err.Error(), "import alice") {
+		t.Fatalf("err = %v, want the failed import", err)
+	}
+	// The name was freed for the import, so the old key had to be put back.
+	if len(fake.keys) != 1 || fake.keys[0].Name != "alice" || fake.keys[0].PublicKey != old.AuthorizedKey() {
+		t.Errorf("keys after a failed rotation = %+v, want the old alice key", fake.keys)
+	}
+}
@@ -0,0 +1,252 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// ErrMaintenance matches, via errors.Is, every *MaintenanceError.
+var ErrMaintenance = errors.New("cloapi: API is in maintenance mode")
+
+// MaintenanceWindow is a parsed MaintenanceModeSchema.
+type MaintenanceWindow struct {
+	Enabled bool
+	// Start and End are zero when the API does not announce them.
+	Start, End time.Time
+	Message    string
+	Reason     string
+}
+
+// Active reports whether the window is in effect at t: maintenance is enabled and
+// its announced start, if any, has passed.
+func (w MaintenanceWindow) Active(t time.Time) bool {
+	return w.Enabled && (w.Start.IsZero() || !t.Before(w.Start))
+}
+
+// MaintenanceError is returned instead of sending a mutating request while the
+// API is in maintenance. errors.Is(err, ErrMaintenance) reports true for it.
+type MaintenanceError struct {
+	Window MaintenanceWindow
+}
+
+func (e *MaintenanceError) Error() string {
+	msg := ErrMaintenance.Error()
+	if !e.Window.End.IsZero() {
+		msg += " until " + e.Window.End.Format(time.RFC3339)
+	}
+	if e.Window.Message != "" {
+		msg += ": " + e.Window.Message
+	}
+	return msg
+}
+
+func (e *MaintenanceError) Is(target error) bool { return target == ErrMaintenance }
+
+// maintenanceTimeLayouts are tried in order when parsing start_time/end_time.
+// Times without a zone are taken as UTC.
+var maintenanceTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999",
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02T15:04",
+}
+
+// ParseMaintenanceWindow converts the API's maintenance status, parsing its
+// StartTime and EndTime strings. On error the window is still filled in, with the
+// unparseable bound left zero.
+func ParseMaintenanceWindow(s *MaintenanceModeSchema) (MaintenanceWindow, error) {
+	w := MaintenanceWindow{Enabled: s.Enable, Message: stringValue(s.Message), Reason: stringValue(s.Reason)}
+	start, startErr := parseMaintenanceTime(stringValue(s.StartTime))
+	end, endErr := parseMaintenanceTime(stringValue(s.EndTime))
+	w.Start, w.End = start, end
+	if startErr != nil {
+		return w, fmt.Errorf("cloapi: maintenance start_time: %w", startErr)
+	}
+	if endErr != nil {
+		return w, fmt.Errorf("cloapi: maintenance end_time: %w", endErr)
+	}
+	return w, nil
+}
+
+// stringValue returns *p, or "" for nil.
+func stringValue(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
+func parseMaintenanceTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range maintenanceTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognised time %q", s)
+}
+
+// MaintenanceConfig controls the maintenance-aware transport.
+type MaintenanceConfig struct {
+	// Interval is how long a fetched status is trusted (default 1 minute). A 503
+	// response marks it stale at once.
+	Interval time.Duration
+	// Wait holds mutating requests until the window ends instead of failing them
+	// with *MaintenanceError. Only the caller's context bounds the wait; the
+	// client timeout applies to the request once it is released.
+	Wait bool
+}
+
+const defaultMaintenanceInterval = time.Minute
+
+// maintenanceStatusTimeout bounds one status fetch, which runs on behalf of
+// every request waiting for it rather than under any one's context.
+const maintenanceStatusTimeout = 10 * time.Second
+
+// maintenanceRetryBackoff is how long a failed status fetch is not retried, so
+// an unreachable status endpoint is not asked before every mutating request. A
+// variable so tests can shorten it.
+var maintenanceRetryBackoff = 5 * time.Second
+
+// maintenanceRoundTripper checks /v2/maintenance-mode at most once per interval
+// and stops mutating requests during maintenance. Reads always go through, as do
+// all requests when the status cannot be fetched: the check must never be the
+// reason a job fails.
+type maintenanceRoundTripper struct {
+	Proxied http.RoundTripper
+	Config  MaintenanceConfig
+	// Server is the API base URL the status is fetched from.
+	Server string
+
+	mu      sync.Mutex
+	window  MaintenanceWindow
+	fetched time.Time // of the window; zero when stale
+	retryAt time.Time // no fetch before it, after a failed one
+	fetches singleflight.Group
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+func (m *maintenanceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isMutating(req.Method) {
+		for {
+			w, err := m.status(req.Context())
+			if err != nil {
+				return nil, err
+			}
+			now := time.Now()
+			if !w.Active(now) {
+				break
+			}
+			if !m.Config.Wait {
+				return nil, &MaintenanceError{Window: w}
+			}
+			// Sleep until the announced end, or one interval if there is none, then
+			// ask the API again: windows get extended.
+			delay := m.interval()
+			if w.End.After(now) {
+				delay = w.End.Sub(now)
+			}
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+				m.invalidate()
+			case <-req.Context().Done():
+				timer.Stop()
+				return nil, req.Context().Err()
+			}
+		}
+	}
+
+	resp, err := m.Proxied.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusServiceUnavailable {
+		m.invalidate()
+	}
+	return resp, err
+}
+
+func (m *maintenanceRoundTripper) interval() time.Duration {
+	if m.Config.Interval > 0 {
+		return m.Config.Interval
+	}
+	return defaultMaintenanceInterval
+}
+
+func (m *maintenanceRoundTripper) invalidate() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fetched, m.retryAt = time.Time{}, time.Time{}
+}
+
+// status returns the cached window, refreshing it when stale. Concurrent
+// requests share one fetch, which none of them owns: it keeps the values of ctx
+// but not its deadline, and each request stops waiting when its own context
+// ends. A failed fetch keeps the previous window and is retried after
+// maintenanceRetryBackoff.
+func (m *maintenanceRoundTripper) status(ctx context.Context) (MaintenanceWindow, error) {
+	m.mu.Lock()
+	w, now := m.window, time.Now()
+	fresh := (!m.fetched.IsZero() && now.Sub(m.fetched) < m.interval()) || now.Before(m.retryAt)
+	m.mu.Unlock()
+	if fresh {
+		return w, nil
+	}
+
+	fetchCtx := context.WithoutCancel(ctx)
+	ch := m.fetches.DoChan("status", func() (any, error) {
+		ctx, cancel := context.WithTimeout(fetchCtx, maintenanceStatusTimeout)
+		defer cancel()
+		w, err := m.fetch(ctx)
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if err != nil {
+			m.retryAt = time.Now().Add(min(maintenanceRetryBackoff, m.interval()))
+			return m.window, nil
+		}
+		m.window, m.fetched = w, time.Now()
+		return w, nil
+	})
+	select {
+	case res := <-ch:
+		return res.Val.(MaintenanceWindow), nil
+	case <-ctx.Done():
+		return MaintenanceWindow{}, ctx.Err()
+	}
+}
+
+// fetch asks the API for its maintenance status.
+func (m *maintenanceRoundTripper) fetch(ctx context.Context) (MaintenanceWindow, error) {
+	req, err := NewMaintenanceModeStatusRequest(m.Server)
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+	resp, err := m.Proxied.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+	parsed, err := ParseMaintenanceModeStatusResponse(resp)
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+	if parsed.OK == nil {
+		return MaintenanceWindow{}, errors.New("cloapi: empty maintenance status")
+	}
+	// An unparseable time leaves that bound zero; Enable is still honoured.
+	w, _ := ParseMaintenanceWindow(parsed.OK)
+	return w, nil
+}
@@ -0,0 +1,223 @@
+package cloapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseMaintenanceWindow(t *testing.T) {
+	for _, in := range []string{"2026-03-01T02:00:00Z", "2026-03-01T02:00:00", "2026-03-01 02:00:00", "2026-03-01T05:00:00+03:00"} {
+		w, err := ParseMaintenanceWindow(&MaintenanceModeSchema{Enable: true, StartTime: &in})
+		if err != nil {
+			t.Fatalf("%q: %v", in, err)
+		}
+		if want := time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC); !w.Start.Equal(want) {
+			t.Errorf("%q parsed as %v", in, w.Start)
+		}
+	}
+	bad := "tomorrow"
+	w, err := ParseMaintenanceWindow(&MaintenanceModeSchema{Enable: true, EndTime: &bad})
+	if err == nil || !w.Enabled || !w.End.IsZero() {
+		t.Fatalf("w = %+v, err = %v", w, err)
+	}
+}
+
+func TestMaintenanceWindowActive(t *testing.T) {
+	now := time.Now()
+	if (MaintenanceWindow{Enabled: true, Start: now.Add(time.Hour)}).Active(now) {
+		t.Error("an announced future window is not active yet")
+	}
+	if !(MaintenanceWindow{Enabled: true}).Active(now) {
+		t.Error("an enabled window without times is active")
+	}
+}
+
+// maintenanceServer serves /v2/maintenance-mode from status and accepts
+// everything else.
+type maintenanceServer struct {
+	mu       sync.Mutex
+	status   MaintenanceModeSchema
+	checks   int
+	requests []string
+	fail     int           // status checks still to answer with a 500
+	hold     chan struct{} // if set, status checks wait until it is closed
+}
+
+func (s *maintenanceServer) set(status MaintenanceModeSchema) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+func (s *maintenanceServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path == "/v2/maintenance-mode" {
+		s.checks++
+		if hold := s.hold; hold != nil {
+			s.mu.Unlock()
+			<-hold
+			s.mu.Lock()
+		}
+		if s.fail > 0 {
+			s.fail--
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(s.status)
+		return
+	}
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	_, _ = w.Write([]byte(`{}`))
+}
+
+func newMaintenanceClient(t *testing.T, fake *maintenanceServer, cfg MaintenanceConfig, opts ...Option) *ClientWithResponses {
+	t.Helper()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	cli, err := New("tok", append([]Option{WithBaseURL(srv.URL), WithMaintenanceCheck(cfg)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func TestMaintenanceBlocksWrites(t *testing.T) {
+	msg, end := "upgrading storage", "2030-01-01T00:00:00Z"
+	fake := &maintenanceServer{status: MaintenanceModeSchema{Enable: true, Message: &msg, EndTime: &end}}
+	cli := newMaintenanceClient(t, fake, MaintenanceConfig{})
+	ctx := context.Background()
+
+	if _, err := cli.ProjectListWithResponse(ctx); err != nil {
+		t.Fatalf("reads must pass during maintenance: %v", err)
+	}
+	_, err := cli.ProjectCreateWithResponse(ctx, ProjectCreateJSONRequestBody{})
+	var mErr *MaintenanceError
+	if !errors.Is(err, ErrMaintenance) || !errors.As(err, &mErr) || mErr.Window.Message != msg || mErr.Window.End.Year() != 2030 {
+		t.Fatalf("err = %v, want *MaintenanceError", err)
+	}
+	_, _ = cli.ProjectCreateWithResponse(ctx, ProjectCreateJSONRequestBody{})
+
+	if fake.checks != 1 {
+		t.Errorf("status fetched %d times, want 1 within the interval", fake.checks)
+	}
+	if len(fake.requests) != 1 || fake.requests[0] != "GET /v2/projects" {
+		t.Errorf("requests reaching the API = %v", fake.requests)
+	}
+}
+
+func TestMaintenanceWaitsForWindowEnd(t *testing.T) {
+	end := time.Now().Add(100 * time.Millisecond).UTC().Format(time.RFC3339Nano)
+	fake := &maintenanceServer{status: MaintenanceModeSchema{Enable: true, EndTime: &end}}
+	cli := newMaintenanceClient(t, fake, MaintenanceConfig{Wait: true})
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		fake.set(MaintenanceModeSchema{Enable: false})
+	}()
+	start := time.Now()
+	if _, err := cli.ProjectCreateWithResponse(context.Background(), ProjectCreateJSONRequestBody{}); err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) < 80*time.Millisecond {
+		t.Error("request was not held until the window ended")
+	}
+
+	// The context bounds the wait.
+	fake.set(MaintenanceModeSchema{Enable: true})
+	cli = newMaintenanceClient(t, fake, MaintenanceConfig{Wait: true})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := cli.ProjectCreateWithResponse(ctx, ProjectCreateJSONRequestBody{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+}
+
+func TestMaintenanceWaitOutlastsTimeout(t *testing.T) {
+	end := time.Now().Add(300 * time.Millisecond).UTC().Format(time.RFC3339Nano)
+	fake := &maintenanceServer{status: MaintenanceModeSchema{Enable: true, EndTime: &end}}
+	cli := newMaintenanceClient(t, fake, MaintenanceConfig{Wait: true, Interval: 50 * time.Millisecond},
+		WithTimeout(50*time.Millisecond))
+
+	go func() {
+		time.Sleep(250 * time.Millisecond)
+		fake.set(MaintenanceModeSchema{Enable: false})
+	}()
+	start := time.Now()
+	if _, err := cli.ProjectCreateWithResponse(context.Background(), ProjectCreateJSONRequestBody{}); err != nil {
+		t.Fatalf("held request failed after %v: %v", time.Since(start), err)
+	}
+	if time.Since(start) < 250*time.Millisecond {
+		t.Error("request was not held until the window ended")
+	}
+}
+
+func TestMaintenanceStatusFailureRetried(t *testing.T) {
+	old := maintenanceRetryBackoff
+	maintenanceRetryBackoff = 30 * time.Millisecond
+	t.Cleanup(func() { maintenanceRetryBackoff = old })
+
+	fake := &maintenanceServer{status: MaintenanceModeSchema{Enable: true}, fail: 1}
+	cli := newMaintenanceClient(t, fake, MaintenanceConfig{Interval: time.Hour})
+	ctx := context.Background()
+
+	// An unknown status lets writes through, and is not asked again at once.
+	for range 2 {
+		if _, err := cli.ProjectCreateWithResponse(ctx, ProjectCreateJSONRequestBody{}); err != nil {
+			t.Fatalf("write with the status unknown: %v", err)
+		}
+	}
+	time.Sleep(40 * time.Millisecond)
+	if _, err := cli.ProjectCreateWithResponse(ctx, ProjectCreateJSONRequestBody{}); !errors.Is(err, ErrMaintenance) {
+		t.Fatalf("err = %v, want the status fetched again after the backoff", err)
+	}
+	if fake.checks != 2 {
+		t.Errorf("status fetched %d times, want 2", fake.checks)
+	}
+}
+
+func TestMaintenanceStatusFetchShared(t *testing.T) {
+	fake := &maintenanceServer{hold: make(chan struct{})}
+	cli := newMaintenanceClient(t, fake, MaintenanceConfig{})
+
+	// The first request starts a fetch that hangs; the second joins it, and
+	// still gives up at its own deadline.
+	patient := make(chan error, 1)
+	go func() {
+		_, err := cli.ProjectCreateWithResponse(context.Background(), ProjectCreateJSONRequestBody{})
+		patient <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	short, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	impatient := make(chan error, 1)
+	go func() {
+		_, err := cli.ProjectCreateWithResponse(short, ProjectCreateJSONRequestBody{})
+		impatient <- err
+	}()
+
+	select {
+	case err := <-impatient:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("impatient request: err = %v, want its own deadline", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("impatient request waited for the hanging fetch")
+	}
+	close(fake.hold)
+	if err := <-patient; err != nil {
+		t.Errorf("patient request: %v", err)
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.checks != 1 {
+		t.Errorf("status fetched %d times, want 1 shared fetch", fake.checks)
+	}
+}
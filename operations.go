@@ -0,0 +1,142 @@
+package cloapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Operation is the metadata of one generated operation; Operations lists them
+// all.
+type Operation struct {
+	// ID is the operationId, which names the generated methods, e.g.
+	// "ServerCreate" for ServerCreateWithResponse.
+	ID     string
+	Method string
+	// Path is the path template, e.g. "/v2/projects/{object_id}/servers".
+	Path string
+	// PathParams are the names of the path parameters, in path order.
+	PathParams []string
+	// RequestType is the Go type of the JSON request body, "" without one or for
+	// a non-JSON body.
+	RequestType string
+	// ResponseType is the Go type of the OK field of the Response, "" if the
+	// operation declares no JSON success body.
+	ResponseType string
+	Tags         []string
+	// List reports a {count, result} envelope, which Paginator, StreamList and
+	// WithPage apply to.
+	List bool
+
+	// newBody returns a new value of RequestType, nil without a JSON body.
+	newBody func() any
+}
+
+// Idempotent reports whether the operation may be repeated safely, which is
+// when the retry transport retries it.
+func (o Operation) Idempotent() bool {
+	return isIdempotent(o.Method)
+}
+
+var operationIndex = sync.OnceValue(func() map[string]*Operation {
+	index := make(map[string]*Operation, len(Operations))
+	for i := range Operations {
+		index[Operations[i].ID] = &Operations[i]
+	}
+	return index
+})
+
+// OperationByID returns the operation with the given operationId.
+func OperationByID(id string) (Operation, bool) {
+	op, ok := operationIndex()[id]
+	if !ok {
+		return Operation{}, false
+	}
+	return *op, true
+}
+
+// MatchOperation returns the operation a request with method and URL path
+// calls, e.g. to label metrics with the path template instead of the path:
+//
+//	if op, ok := cloapi.MatchOperation(req.Method, req.URL.Path); ok {
+//		labels = append(labels, op.ID, op.Path)
+//	}
+//
+// Where templates overlap, such as /v2/servers/{object_id} and a literal
+// /v2/servers/related-resources, the one with fewer parameters wins.
+func MatchOperation(method, path string) (Operation, bool) {
+	var best *Operation
+	for i := range Operations {
+		op := &Operations[i]
+		if op.Method != strings.ToUpper(method) || !matchPathTemplate(op.Path, path) {
+			continue
+		}
+		if best == nil || len(op.PathParams) < len(best.PathParams) {
+			best = op
+		}
+	}
+	if best == nil {
+		return Operation{}, false
+	}
+	return *best, true
+}
+
+// openAPISpec is set by spec_embed.go.
+var openAPISpec []byte
+
+// OpenAPISpec returns the fixed OpenAPI document the client was generated from,
+// spec/openapi.snapshot.json, or nil in a checkout that has none.
+func OpenAPISpec() []byte {
+	return openAPISpec
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// OperationsFromSpec reads the operations of an OpenAPI document loaded at run
+// time, e.g. a spec fetched after this build or OpenAPISpec(), for
+// InvokeOperation. They carry no Go types: RequestType and ResponseType are
+// empty. Operations are sorted by path, then method.
+func OperationsFromSpec(doc []byte) ([]Operation, error) {
+	var root map[string]any
+	if err := json.Unmarshal(doc, &root); err != nil {
+		return nil, fmt.Errorf("cloapi: parse spec: %w", err)
+	}
+	s := &specValidator{root: root}
+	var ops []Operation
+	for path, item := range jsonObject(root["paths"]) {
+		for method, raw := range jsonObject(item) {
+			method = strings.ToUpper(method)
+			if !slices.Contains([]string{"GET", "PUT", "POST", "DELETE", "PATCH", "HEAD", "OPTIONS"}, method) {
+				continue // parameters, summary, ...
+			}
+			spec := jsonObject(raw)
+			op := Operation{Method: method, Path: path, PathParams: []string{}}
+			op.ID, _ = spec["operationId"].(string)
+			if tags, ok := spec["tags"].([]any); ok {
+				for _, t := range tags {
+					if t, ok := t.(string); ok {
+						op.Tags = append(op.Tags, t)
+					}
+				}
+			}
+			for _, m := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+				op.PathParams = append(op.PathParams, m[1])
+			}
+			ok := s.resolve(jsonObject(jsonObject(spec["responses"])["200"]))
+			schema := s.resolve(jsonObject(jsonObject(jsonObject(ok["content"])["application/json"])["schema"]))
+			props := jsonObject(schema["properties"])
+			op.List = props["count"] != nil && props["result"] != nil
+			ops = append(ops, op)
+		}
+	}
+	slices.SortFunc(ops, func(a, b Operation) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Method, b.Method)
+	})
+	return ops, nil
+}
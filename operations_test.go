@@ -0,0 +1,85 @@
+package cloapi
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestOperationsRegistry(t *testing.T) {
+	if len(Operations) == 0 {
+		t.Fatal("no operations")
+	}
+	seen := map[string]bool{}
+	for _, op := range Operations {
+		if seen[op.ID] {
+			t.Errorf("duplicate operation %s", op.ID)
+		}
+		seen[op.ID] = true
+		if got, ok := OperationByID(op.ID); !ok || got.Path != op.Path {
+			t.Errorf("OperationByID(%s) = %+v, %v", op.ID, got, ok)
+		}
+		if n := strings.Count(op.Path, "{"); n != len(op.PathParams) {
+			t.Errorf("%s: %d placeholders, %d path params", op.ID, n, len(op.PathParams))
+		}
+		for _, p := range op.PathParams {
+			if !strings.Contains(op.Path, "{"+p+"}") {
+				t.Errorf("%s: param %s not in %s", op.ID, p, op.Path)
+			}
+		}
+	}
+	if _, ok := OperationByID("NoSuchOperation"); ok {
+		t.Error("found an unknown operation")
+	}
+}
+
+func TestOperationsMatchGeneratedRequests(t *testing.T) {
+	for _, tc := range []struct {
+		id    string
+		build func() (*http.Request, error)
+	}{
+		{"ServerCreate", func() (*http.Request, error) {
+			return NewServerCreateRequest("https://api.example", "p1", ServerCreateJSONRequestBody{})
+		}},
+		{"ProjectServerList", func() (*http.Request, error) { return NewProjectServerListRequest("https://api.example", "p1") }},
+		{"AccountStat", func() (*http.Request, error) { return NewAccountStatRequest("https://api.example") }},
+	} {
+		req, err := tc.build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		op, ok := MatchOperation(req.Method, req.URL.Path)
+		if !ok || op.ID != tc.id {
+			t.Errorf("MatchOperation(%s %s) = %s, %v; want %s", req.Method, req.URL.Path, op.ID, ok, tc.id)
+		}
+	}
+}
+
+func TestOperationMetadata(t *testing.T) {
+	op, _ := OperationByID("ProjectServerList")
+	if op.Method != http.MethodGet || op.Path != "/v2/projects/{object_id}/servers" || !op.List ||
+		op.ResponseType != "PagServersSchema" || !op.Idempotent() {
+		t.Errorf("ProjectServerList = %+v", op)
+	}
+	op, _ = OperationByID("ServerCreate")
+	if op.RequestType != "ServerCreateJSONRequestBody" || op.List || op.Idempotent() {
+		t.Errorf("ServerCreate = %+v", op)
+	}
+	if _, ok := MatchOperation(http.MethodPatch, "/v2/nowhere"); ok {
+		t.Error("matched an unknown path")
+	}
+}
+
+// TestMatchOperationUnambiguous checks every operation is found again from a
+// concrete path built from its own template.
+func TestMatchOperationUnambiguous(t *testing.T) {
+	for _, op := range Operations {
+		path := op.Path
+		for _, p := range op.PathParams {
+			path = strings.Replace(path, "{"+p+"}", "id-"+p, 1)
+		}
+		if got, ok := MatchOperation(op.Method, path); !ok || got.ID != op.ID {
+			t.Errorf("MatchOperation(%s %s) = %s, want %s", op.Method, path, got.ID, op.ID)
+		}
+	}
+}
@@ -86,3 +86,15 @@ func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
 	}
 	return all, nil
 }
+
+// listAll drains a {count, result} list endpoint. page wraps one generated call,
+// applying the pagination editor it is given and returning the envelope fields.
+func listAll[T any](ctx context.Context, page func(ctx context.Context, paging RequestEditorFn) (count int, result *[]T, err error)) ([]T, error) {
+	return NewPaginator(0, func(ctx context.Context, limit, offset int) ([]T, int, error) {
+		count, result, err := page(ctx, WithPage(limit, offset))
+		if err != nil || result == nil {
+			return nil, count, err
+		}
+		return *result, count, nil
+	}).All(ctx)
+}
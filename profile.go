@@ -0,0 +1,279 @@
+package cloapi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by LoadProfile. They override values from the
+// config file, as does DefaultTokenEnv (CLO_TOKEN) for the token; see LoadProfile
+// for the full precedence.
+const (
+	EnvConfig  = "CLO_CONFIG"   // path of the config file
+	EnvProfile = "CLO_PROFILE"  // profile to use when none is named
+	EnvBaseURL = "CLO_BASE_URL" // overrides base_url
+	EnvProject = "CLO_PROJECT"  // overrides project
+	EnvTimeout = "CLO_TIMEOUT"  // overrides timeout, e.g. "15s"
+)
+
+// DefaultProfile is used when no profile is named anywhere.
+const DefaultProfile = "default"
+
+// ConfigFile is the on-disk configuration, by default ~/.config/clo/config.yaml:
+//
+//	default_profile: production
+//	profiles:
+//	  production:
+//	    token_command: [clo-credentials, production]
+//	    project: 7c4e...
+//	    retry: {count: 3, backoff: 500ms}
+//	    rate_limit: {rps: 10, burst: 20}
+//	  staging:
+//	    base_url: https://api.staging.clo.ru
+//	    token_file: ~/.config/clo/staging.token
+//	    timeout: 10s
+type ConfigFile struct {
+	DefaultProfile string              `yaml:"default_profile"`
+	Profiles       map[string]*Profile `yaml:"profiles"`
+}
+
+// Profile holds the settings of one account/endpoint pair. At most one of the
+// token fields may be set.
+type Profile struct {
+	// Name is the key of the profile in the config file.
+	Name string `yaml:"-"`
+
+	BaseURL string `yaml:"base_url"`
+	// Token is the API token itself. Prefer TokenEnv, TokenFile or TokenCommand
+	// so the config file holds no secret.
+	Token        string   `yaml:"token"`
+	TokenEnv     string   `yaml:"token_env"`
+	TokenFile    string   `yaml:"token_file"`
+	TokenCommand []string `yaml:"token_command"`
+
+	// Project is the default project ID. The client does not use it itself; it
+	// is for callers of project-scoped endpoints.
+	Project   string           `yaml:"project"`
+	Timeout   time.Duration    `yaml:"timeout"`
+	Retry     *RetryConfig     `yaml:"retry"`
+	RateLimit *RateLimitConfig `yaml:"rate_limit"`
+}
+
+// RateLimitConfig is the rate_limit section of a profile; see WithRateLimit.
+type RateLimitConfig struct {
+	RPS   float64 `yaml:"rps"`
+	Burst int     `yaml:"burst"`
+}
+
+// DefaultConfigPath returns the config file location: $CLO_CONFIG if set, else
+// clo/config.yaml under $XDG_CONFIG_HOME, falling back to ~/.config.
+func DefaultConfigPath() (string, error) {
+	if p := os.Getenv(EnvConfig); p != "" {
+		return p, nil
+	}
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("cloapi: locate config file: %w", err)
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "clo", "config.yaml"), nil
+}
+
+// ReadConfigFile parses the config file at path. Unknown keys are rejected, so
+// a typo does not silently fall back to a default.
+func ReadConfigFile(path string) (*ConfigFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cloapi: config file: %w", err)
+	}
+	defer f.Close()
+
+	cfg := &ConfigFile{}
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("cloapi: config file %s: %w", path, err)
+	}
+	for name, p := range cfg.Profiles {
+		if p == nil {
+			p = &Profile{}
+			cfg.Profiles[name] = p
+		}
+		p.Name = name
+	}
+	return cfg, nil
+}
+
+// Profile returns the named profile, or the one selected by CLO_PROFILE,
+// default_profile or DefaultProfile, in that order, when name is empty.
+func (c *ConfigFile) Profile(name string) (*Profile, error) {
+	name = firstNonEmpty(name, os.Getenv(EnvProfile), c.DefaultProfile, DefaultProfile)
+	p, ok := c.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("cloapi: profile %q not found in config file", name)
+	}
+	cp := *p
+	return &cp, nil
+}
+
+// LoadProfile reads the default config file and resolves a profile. Each setting
+// is taken from the first source that has it:
+//
+//  1. Options passed to NewFromProfile or Profile.New
+//  2. environment: CLO_TOKEN, CLO_BASE_URL, CLO_PROJECT, CLO_TIMEOUT
+//  3. the profile in the config file
+//  4. the defaults of New
+//
+// The profile itself is name if given, else $CLO_PROFILE, else default_profile,
+// else "default". A missing config file is not an error when no profile was
+// asked for by name or CLO_PROFILE: the environment alone is then used.
+func LoadProfile(name string) (*Profile, error) {
+	path, err := DefaultConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := ReadConfigFile(path)
+	switch {
+	case err == nil:
+	case errors.Is(err, os.ErrNotExist) && name == "" && os.Getenv(EnvProfile) == "":
+		cfg = &ConfigFile{Profiles: map[string]*Profile{DefaultProfile: {Name: DefaultProfile}}}
+	default:
+		return nil, err
+	}
+	p, err := cfg.Profile(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.applyEnv(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// applyEnv overlays the CLO_* environment variables on the profile.
+func (p *Profile) applyEnv() error {
+	if tok := strings.TrimSpace(os.Getenv(DefaultTokenEnv)); tok != "" {
+		p.Token, p.TokenEnv, p.TokenFile, p.TokenCommand = tok, "", "", nil
+	}
+	if v := os.Getenv(EnvBaseURL); v != "" {
+		p.BaseURL = v
+	}
+	if v := os.Getenv(EnvProject); v != "" {
+		p.Project = v
+	}
+	if v := os.Getenv(EnvTimeout); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("cloapi: %s: %w", EnvTimeout, err)
+		}
+		p.Timeout = d
+	}
+	return nil
+}
+
+// TokenSource returns the source described by the profile's token fields.
+func (p *Profile) TokenSource() (TokenSource, error) {
+	set := 0
+	for _, ok := range []bool{p.Token != "", p.TokenEnv != "", p.TokenFile != "", len(p.TokenCommand) > 0} {
+		if ok {
+			set++
+		}
+	}
+	if set > 1 {
+		return nil, fmt.Errorf("cloapi: profile %q sets more than one token field", p.Name)
+	}
+
+	switch {
+	case p.Token != "":
+		return StaticToken(p.Token), nil
+	case p.TokenEnv != "":
+		return EnvToken(p.TokenEnv), nil
+	case p.TokenFile != "":
+		return FileToken(expandHome(p.TokenFile)), nil
+	case len(p.TokenCommand) > 0:
+		return ExecToken(expandHome(p.TokenCommand[0]), p.TokenCommand[1:]...), nil
+	default:
+		return nil, fmt.Errorf("cloapi: profile %q has no token; set one in the config file or %s", p.Name, DefaultTokenEnv)
+	}
+}
+
+// Options translates the profile into client Options.
+func (p *Profile) Options() ([]Option, error) {
+	ts, err := p.TokenSource()
+	if err != nil {
+		return nil, err
+	}
+	opts := []Option{WithTokenSource(ts)}
+	if p.BaseURL != "" {
+		opts = append(opts, WithBaseURL(p.BaseURL))
+	}
+	if p.Timeout > 0 {
+		opts = append(opts, WithTimeout(p.Timeout))
+	}
+	if p.Retry != nil {
+		opts = append(opts, WithRetry(p.Retry.Count, p.Retry.Backoff))
+	}
+	if p.RateLimit != nil && p.RateLimit.RPS > 0 {
+		opts = append(opts, WithRateLimit(p.RateLimit.RPS, p.RateLimit.Burst))
+	}
+	return opts, nil
+}
+
+// New builds a client from the profile. opts are applied after the profile's own
+// settings, so they take precedence.
+func (p *Profile) New(opts ...Option) (*ClientWithResponses, error) {
+	base, err := p.Options()
+	if err != nil {
+		return nil, err
+	}
+	return New("", append(base, opts...)...)
+}
+
+// NewFromProfile loads a profile (see LoadProfile for the precedence rules) and
+// builds a client from it. The profile is returned too, for its Project:
+//
+//	cli, prof, err := cloapi.NewFromProfile("", cloapi.WithLogger(logger))
+//	servers, err := cli.ProjectServerListWithResponse(ctx, prof.Project)
+func NewFromProfile(name string, opts ...Option) (*ClientWithResponses, *Profile, error) {
+	p, err := LoadProfile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	cli, err := p.New(opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cli, p, nil
+}
+
+// expandHome expands a leading "~/" to the user's home directory.
+func expandHome(path string) string {
+	rest, ok := strings.CutPrefix(path, "~/")
+	if !ok {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, rest)
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
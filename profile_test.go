@@ -0,0 +1,184 @@
+package cloapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testConfig = `default_profile: staging
+profiles:
+  production:
+    token: prod-token
+    project: p-prod
+    retry: {count: 2, backoff: 10ms}
+    rate_limit: {rps: 5, burst: 2}
+  staging:
+    base_url: %s
+    token_env: CLO_TEST_STAGING_TOKEN
+    project: p-staging
+    timeout: 5s
+`
+
+// writeConfig points CLO_CONFIG at a fresh config file and clears the other
+// CLO_* variables so the host environment cannot leak into the test.
+func writeConfig(t *testing.T, body string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvConfig, path)
+	for _, v := range []string{EnvProfile, EnvBaseURL, EnvProject, EnvTimeout, DefaultTokenEnv} {
+		t.Setenv(v, "")
+	}
+}
+
+func TestLoadProfileSelection(t *testing.T) {
+	writeConfig(t, strings.Replace(testConfig, "%s", "https://staging.example", 1))
+
+	p, err := LoadProfile("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "staging" || p.Timeout != 5*time.Second || p.BaseURL != "https://staging.example" {
+		t.Fatalf("default_profile: got %+v", p)
+	}
+
+	t.Setenv(EnvProfile, "production")
+	p, err = LoadProfile("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "production" || p.Retry.Count != 2 || p.Retry.Backoff != 10*time.Millisecond || p.RateLimit.Burst != 2 {
+		t.Fatalf("CLO_PROFILE: got %+v", p)
+	}
+
+	// An explicit name beats CLO_PROFILE.
+	if p, _ = LoadProfile("staging"); p.Name != "staging" {
+		t.Fatalf("explicit name: got %q", p.Name)
+	}
+	if _, err := LoadProfile("missing"); err == nil {
+		t.Fatal("want error for an unknown profile")
+	}
+}
+
+func TestLoadProfileEnvOverrides(t *testing.T) {
+	writeConfig(t, strings.Replace(testConfig, "%s", "https://staging.example", 1))
+	t.Setenv(DefaultTokenEnv, "env-token")
+	t.Setenv(EnvProject, "p-env")
+	t.Setenv(EnvTimeout, "2s")
+	t.Setenv(EnvBaseURL, "https://env.example")
+
+	p, err := LoadProfile("staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Token != "env-token" || p.TokenEnv != "" || p.Project != "p-env" ||
+		p.Timeout != 2*time.Second || p.BaseURL != "https://env.example" {
+		t.Fatalf("got %+v", p)
+	}
+
+	t.Setenv(EnvTimeout, "soon")
+	if _, err := LoadProfile("staging"); err == nil {
+		t.Fatal("want error for a bad CLO_TIMEOUT")
+	}
+}
+
+func TestLoadProfileWithoutFile(t *testing.T) {
+	writeConfig(t, "")
+	t.Setenv(EnvConfig, filepath.Join(t.TempDir(), "absent.yaml"))
+	t.Setenv(DefaultTokenEnv, "env-only")
+
+	p, err := LoadProfile("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Token != "env-only" {
+		t.Fatalf("got %+v", p)
+	}
+	if _, err := LoadProfile("production"); err == nil {
+		t.Fatal("want error when a named profile has no config file")
+	}
+}
+
+func TestReadConfigFileRejectsUnknownKeys(t *testing.T) {
+	writeConfig(t, "profiles:\n  default:\n    tokne: x\n")
+	if _, err := LoadProfile(""); err == nil || !strings.Contains(err.Error(), "tokne") {
+		t.Fatalf("err = %v, want unknown field error", err)
+	}
+}
+
+func TestProfileTokenSource(t *testing.T) {
+	if _, err := (&Profile{Name: "x", Token: "a", TokenEnv: "B"}).TokenSource(); err == nil {
+		t.Error("want error for two token fields")
+	}
+	if _, err := (&Profile{Name: "x"}).TokenSource(); err == nil {
+		t.Error("want error for no token")
+	}
+	ts, err := (&Profile{TokenCommand: []string{"echo", "cmd-token"}}).TokenSource()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok, err := ts.Token(context.Background()); err != nil || tok != "cmd-token" {
+		t.Fatalf("tok = %q, err = %v", tok, err)
+	}
+}
+
+func TestNewFromProfile(t *testing.T) {
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count":0,"result":[]}`))
+	}))
+	defer srv.Close()
+
+	writeConfig(t, strings.Replace(testConfig, "%s", srv.URL, 1))
+	t.Setenv("CLO_TEST_STAGING_TOKEN", "staging-token")
+
+	cli, prof, err := NewFromProfile("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.ProjectServerListWithResponse(context.Background(), prof.Project); err != nil {
+		t.Fatal(err)
+	}
+	if gotAuth != "Bearer staging-token" {
+		t.Errorf("Authorization = %q", gotAuth)
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	cli, err := New("tok", WithBaseURL(srv.URL), WithRateLimit(20, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := cli.AccountBalanceWithResponse(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Burst 1 at 20/s: the 2nd and 3rd requests wait ~50ms each.
+	if d := time.Since(start); d < 90*time.Millisecond {
+		t.Errorf("3 requests took %v, want them spaced by the limiter", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cli.AccountBalanceWithResponse(ctx); err == nil {
+		t.Error("want error for a cancelled context")
+	}
+}
@@ -0,0 +1,334 @@
+package cloapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Resolver maps human-readable names to the IDs the API expects, e.g. an image
+// "ubuntu-22.04" or a keypair "ci-key", within one project. Each catalog is listed
+// once and cached for the lifetime of the Resolver (see Invalidate). An ID is
+// accepted wherever a name is, so configs may use either.
+//
+//	r := cloapi.NewResolver(cli, projectID)
+//	imageID, err := r.Image(ctx, "ubuntu-22.04")
+//	var nf *cloapi.NameNotFoundError
+//	if errors.As(err, &nf) {
+//		fmt.Println("did you mean", nf.Suggestions)
+//	}
+type Resolver struct {
+	cli       ClientWithResponsesInterface
+	projectID string
+
+	mu    sync.Mutex
+	cache map[string][]namedEntry
+	gen   int // bumped by Invalidate, so loads it overtook are not cached
+	loads singleflight.Group
+}
+
+// Kinds of objects a Resolver resolves, as reported in its errors.
+const (
+	KindImage     = "image"
+	KindRecipe    = "recipe"
+	KindKeypair   = "keypair"
+	KindDatastore = "datastore"
+	KindNetwork   = "network"
+)
+
+// namedEntry is one catalog object with every name it may be referred to by.
+type namedEntry struct {
+	id    string
+	names []string
+}
+
+// NewResolver creates a Resolver for the given project.
+func NewResolver(cli ClientWithResponsesInterface, projectID string) *Resolver {
+	return &Resolver{cli: cli, projectID: projectID, cache: map[string][]namedEntry{}}
+}
+
+// Image resolves an image by name or by its "distribution-version" slug, e.g.
+// "ubuntu-22.04".
+func (r *Resolver) Image(ctx context.Context, name string) (string, error) {
+	return r.resolve(ctx, KindImage, name)
+}
+
+// Recipe resolves a recipe by name, e.g. "wordpress".
+func (r *Resolver) Recipe(ctx context.Context, name string) (string, error) {
+	return r.resolve(ctx, KindRecipe, name)
+}
+
+// Keypair resolves a keypair by name, e.g. "ci-key".
+func (r *Resolver) Keypair(ctx context.Context, name string) (string, error) {
+	return r.resolve(ctx, KindKeypair, name)
+}
+
+// Datastore resolves a DBaaS datastore by "name version", e.g. "postgres 15".
+// A bare name resolves only if a single version is offered.
+func (r *Resolver) Datastore(ctx context.Context, name string) (string, error) {
+	return r.resolve(ctx, KindDatastore, name)
+}
+
+// Network resolves a network by its subnet address, e.g. "10.0.0.0/24". Networks
+// carry no name in the API, so the subnet is the human-facing handle.
+func (r *Resolver) Network(ctx context.Context, subnet string) (string, error) {
+	return r.resolve(ctx, KindNetwork, subnet)
+}
+
+// Invalidate drops every cached catalog, so the next lookup lists it again.
+func (r *Resolver) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache = map[string][]namedEntry{}
+	r.gen++
+}
+
+func (r *Resolver) resolve(ctx context.Context, kind, name string) (string, error) {
+	entries, err := r.entries(ctx, kind)
+	if err != nil {
+		return "", err
+	}
+
+	key := normalizeName(name)
+	var ids []string
+	for _, e := range entries {
+		if e.id == name {
+			return e.id, nil
+		}
+		for _, n := range e.names {
+			if normalizeName(n) == key {
+				ids = append(ids, e.id)
+				break
+			}
+		}
+	}
+	switch len(ids) {
+	case 1:
+		return ids[0], nil
+	case 0:
+		return "", &NameNotFoundError{Kind: kind, Name: name, Suggestions: suggest(name, entries)}
+	default:
+		return "", &AmbiguousNameError{Kind: kind, Name: name, IDs: ids}
+	}
+}
+
+// entries returns the catalog of kind, listing it on first use. The listing runs
+// outside the lock, so lookups of other kinds are not held up by it, and
+// concurrent lookups of the same kind share one listing. That listing belongs to
+// none of them: it keeps the values of the first caller's context but not its
+// deadline, leaving the client's timeout to bound it, and each caller stops
+// waiting when its own context ends.
+func (r *Resolver) entries(ctx context.Context, kind string) ([]namedEntry, error) {
+	r.mu.Lock()
+	entries, ok := r.cache[kind]
+	gen := r.gen
+	r.mu.Unlock()
+	if ok {
+		return entries, nil
+	}
+
+	loadCtx := context.WithoutCancel(ctx)
+	ch := r.loads.DoChan(fmt.Sprintf("%d/%s", gen, kind), func() (any, error) {
+		entries, err := r.load(loadCtx, kind)
+		if err != nil {
+			return nil, err
+		}
+		r.mu.Lock()
+		if r.gen == gen {
+			r.cache[kind] = entries
+		}
+		r.mu.Unlock()
+		return entries, nil
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]namedEntry), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *Resolver) load(ctx context.Context, kind string) ([]namedEntry, error) {
+	var entries []namedEntry
+	switch kind {
+	case KindImage:
+		items, err := listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]SchemasResponseV2ImageImageSchema, error) {
+			resp, err := r.cli.ProjectImagesListWithResponse(ctx, r.projectID, paging)
+			if err != nil || resp.OK == nil {
+				return 0, nil, err
+			}
+			return resp.OK.Count, resp.OK.Result, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range items {
+			os := it.OperationSystem
+			entries = append(entries, namedEntry{id: it.Id, names: []string{it.Name, os.Distribution + "-" + os.Version}})
+		}
+	case KindRecipe:
+		items, err := listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]SchemasResponseV2RecipeRecipeSchema, error) {
+			resp, err := r.cli.ProjectRecipesWithResponse(ctx, r.projectID, paging)
+			if err != nil || resp.OK == nil {
+				return 0, nil, err
+			}
+			return resp.OK.Count, resp.OK.Result, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range items {
+			entries = append(entries, namedEntry{id: it.Id, names: []string{it.Name}})
+		}
+	case KindKeypair:
+		items, err := listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]KeyPairSchema, error) {
+			resp, err := r.cli.KeyPairsListWithResponse(ctx, r.projectID, paging)
+			if err != nil || resp.OK == nil {
+				return 0, nil, err
+			}
+			return resp.OK.Count, resp.OK.Result, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range items {
+			entries = append(entries, namedEntry{id: it.Id, names: []string{it.Name}})
+		}
+	case KindDatastore:
+		items, err := listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]DatastoreSchema, error) {
+			resp, err := r.cli.ProjectDbaasDatastoresWithResponse(ctx, r.projectID, paging)
+			if err != nil || resp.OK == nil {
+				return 0, nil, err
+			}
+			return resp.OK.Count, resp.OK.Result, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range items {
+			entries = append(entries, namedEntry{id: it.Id, names: []string{it.Name + " " + it.Version, it.Name}})
+		}
+	case KindNetwork:
+		items, err := listAll(ctx, func(ctx context.Context, paging RequestEditorFn) (int, *[]NetworkSchema, error) {
+			resp, err := r.cli.NetworksListWithResponse(ctx, r.projectID, paging)
+			if err != nil || resp.OK == nil {
+				return 0, nil, err
+			}
+			return resp.OK.Count, resp.OK.Result, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range items {
+			entries = append(entries, namedEntry{id: it.Id, names: []string{it.SubnetAddress}})
+		}
+	default:
+		return nil, fmt.Errorf("cloapi: unknown resolver kind %q", kind)
+	}
+	return entries, nil
+}
+
+// NameNotFoundError reports a name that matched nothing, with the closest known
+// names as suggestions.
+type NameNotFoundError struct {
+	Kind        string
+	Name        string
+	Suggestions []string
+}
+
+func (e *NameNotFoundError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("cloapi: no %s named %q", e.Kind, e.Name)
+	}
+	return fmt.Sprintf("cloapi: no %s named %q (did you mean %s?)", e.Kind, e.Name, strings.Join(quoteAll(e.Suggestions), ", "))
+}
+
+// AmbiguousNameError reports a name shared by several objects; refer to one of
+// them by ID instead.
+type AmbiguousNameError struct {
+	Kind string
+	Name string
+	IDs  []string
+}
+
+func (e *AmbiguousNameError) Error() string {
+	return fmt.Sprintf("cloapi: %s name %q is ambiguous, matches %s", e.Kind, e.Name, strings.Join(e.IDs, ", "))
+}
+
+// normalizeName makes matching case-insensitive and treats runs of spaces,
+// underscores and hyphens alike, so "Ubuntu 22.04" finds "ubuntu-22.04".
+func normalizeName(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), "-")
+}
+
+// maxSuggestions caps the suggestions attached to a NameNotFoundError.
+const maxSuggestions = 3
+
+// suggest returns the known names closest to name by edit distance, limited to
+// plausible typos.
+func suggest(name string, entries []namedEntry) []string {
+	type candidate struct {
+		name string
+		dist int
+	}
+	key := normalizeName(name)
+	seen := map[string]bool{}
+	var cands []candidate
+	for _, e := range entries {
+		for _, n := range e.names {
+			if n == "" || seen[n] {
+				continue
+			}
+			seen[n] = true
+			norm := normalizeName(n)
+			d := levenshtein(key, norm)
+			if d <= max(2, len(key)/3) || strings.Contains(norm, key) {
+				cands = append(cands, candidate{n, d})
+			}
+		}
+	}
+	sort.SliceStable(cands, func(i, j int) bool { return cands[i].dist < cands[j].dist })
+	var out []string
+	for i := 0; i < len(cands) && i < maxSuggestions; i++ {
+		out = append(out, cands[i].name)
+	}
+	return out
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func quoteAll(ss []string) []string {
+	out := make([]string, len(ss))
+	for i, s := range ss {
+		out[i] = fmt.Sprintf("%q", s)
+	}
+	return out
+}
@@ -0,0 +1,189 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newCatalogServer serves fixed JSON bodies by path and counts the hits per path.
+func newCatalogServer(t *testing.T, bodies map[string]string) (*ClientWithResponses, map[string]*int32) {
+	t.Helper()
+	hits := map[string]*int32{}
+	for path := range bodies {
+		hits[path] = new(int32)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		atomic.AddInt32(hits[r.URL.Path], 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli, hits
+}
+
+func TestResolver(t *testing.T) {
+	cli, hits := newCatalogServer(t, map[string]string{
+		"/v2/projects/p1/images": `{"count":2,"result":[
+			{"id":"img-1","name":"Ubuntu 22.04 LTS","operation_system":{"distribution":"ubuntu","version":"22.04"}},
+			{"id":"img-2","name":"Debian 12","operation_system":{"distribution":"debian","version":"12"}}]}`,
+		"/v2/projects/p1/keypairs": `{"count":3,"result":[
+			{"id":"k-1","name":"ci-key"},{"id":"k-2","name":"dup"},{"id":"k-3","name":"dup"}]}`,
+		"/v2/projects/p1/dbaas/datastores": `{"count":2,"result":[
+			{"id":"ds-1","name":"postgres","version":"15"},{"id":"ds-2","name":"postgres","version":"16"}]}`,
+	})
+	r := NewResolver(cli, "p1")
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		resolve func(context.Context, string) (string, error)
+		in      string
+		want    string
+	}{
+		{"image slug", r.Image, "ubuntu-22.04", "img-1"},
+		{"image name any case", r.Image, "debian 12", "img-2"},
+		{"image by id", r.Image, "img-2", "img-2"},
+		{"keypair", r.Keypair, "ci-key", "k-1"},
+		{"datastore with version", r.Datastore, "postgres 15", "ds-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.resolve(ctx, tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if n := atomic.LoadInt32(hits["/v2/projects/p1/images"]); n != 1 {
+		t.Errorf("images listed %d times, want 1 (cached)", n)
+	}
+
+	var amb *AmbiguousNameError
+	if _, err := r.Keypair(ctx, "dup"); !errors.As(err, &amb) || !reflect.DeepEqual(amb.IDs, []string{"k-2", "k-3"}) {
+		t.Errorf("err = %v, want ambiguity over k-2, k-3", err)
+	}
+	if _, err := r.Datastore(ctx, "postgres"); !errors.As(err, &amb) {
+		t.Errorf("err = %v, want ambiguity across versions", err)
+	}
+
+	var nf *NameNotFoundError
+	if _, err := r.Keypair(ctx, "ci-kye"); !errors.As(err, &nf) || len(nf.Suggestions) == 0 || nf.Suggestions[0] != "ci-key" {
+		t.Errorf("err = %v, want suggestion ci-key", err)
+	}
+
+	r.Invalidate()
+	if _, err := r.Image(ctx, "img-1"); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(hits["/v2/projects/p1/images"]); n != 2 {
+		t.Errorf("images listed %d times after Invalidate, want 2", n)
+	}
+}
+
+func TestResolverLoadsOutsideLock(t *testing.T) {
+	var imageLists int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/projects/p1/images":
+			atomic.AddInt32(&imageLists, 1)
+			<-release
+			_, _ = w.Write([]byte(`{"count":1,"result":[{"id":"img-1","name":"Debian 12"}]}`))
+		case "/v2/projects/p1/keypairs":
+			_, _ = w.Write([]byte(`{"count":1,"result":[{"id":"k-1","name":"ci-key"}]}`))
+		}
+	}))
+	defer srv.Close()
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewResolver(cli, "p1")
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if id, err := r.Image(ctx, "debian 12"); err != nil || id != "img-1" {
+				t.Errorf("Image = %q, %v", id, err)
+			}
+		}()
+	}
+	for atomic.LoadInt32(&imageLists) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The image listing is stuck on the wire; another kind still resolves.
+	if id, err := r.Keypair(ctx, "ci-key"); err != nil || id != "k-1" {
+		t.Errorf("Keypair = %q, %v", id, err)
+	}
+	close(release)
+	wg.Wait()
+	if n := atomic.LoadInt32(&imageLists); n != 1 {
+		t.Errorf("images listed %d times, want 1 shared listing", n)
+	}
+}
+
+func TestResolverSharedLoadOutlivesCaller(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count":1,"result":[{"id":"img-1","name":"Debian 12"}]}`))
+	}))
+	defer srv.Close()
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewResolver(cli, "p1")
+
+	// The first caller starts the listing and gives up; the second joins it.
+	short, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	impatient := make(chan error, 1)
+	go func() {
+		_, err := r.Image(short, "debian 12")
+		impatient <- err
+	}()
+	patient := make(chan error, 1)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		id, err := r.Image(context.Background(), "debian 12")
+		if err == nil && id != "img-1" {
+			err = errors.New("resolved " + id)
+		}
+		patient <- err
+	}()
+
+	if err := <-impatient; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("impatient caller: err = %v, want its own deadline", err)
+	}
+	close(release)
+	if err := <-patient; err != nil {
+		t.Errorf("patient caller: %v", err)
+	}
+}
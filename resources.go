@@ -0,0 +1,221 @@
+package cloapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// Resource names used by the config-dependency rules as target/dependent.
+const (
+	ResourceVcpus = "vcpus"
+	ResourceRAM   = "ram"
+	ResourceDisk  = "disk"
+)
+
+// ResourceValues maps resource names (ResourceVcpus, ResourceRAM, ...) to amounts, in
+// the units the rules report through TargetMeasure/DependentMeasure.
+type ResourceValues map[string]int
+
+// ResourceRules interprets the ConfigDepSchema rules returned by
+// ProjectServerConfigDep and ProjectDbaasConfigDep. Each rule bounds its target to
+// [MinTargetValue, MaxTargetValue] (a zero max is unbounded), and each of its
+// dependencies says that once the target reaches Value, the dependent must be at
+// least MinDependent. The same rules drive server and DBaaS create/resize checks:
+//
+//	rules, err := cloapi.LoadServerRules(ctx, cli, projectID)
+//	if err := rules.Check(cloapi.ResourceValues{cloapi.ResourceVcpus: 4, cloapi.ResourceRAM: 8}); err != nil {
+//		...
+//	}
+type ResourceRules []ConfigDepSchema
+
+// LoadServerRules fetches the server flavor rules of a project.
+func LoadServerRules(ctx context.Context, cli ClientWithResponsesInterface, projectID string, reqEditors ...RequestEditorFn) (ResourceRules, error) {
+	resp, err := cli.ProjectServerConfigDepWithResponse(ctx, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return rulesOf(resp.OK), nil
+}
+
+// LoadDbaasRules fetches the DBaaS cluster flavor rules of a project.
+func LoadDbaasRules(ctx context.Context, cli ClientWithResponsesInterface, projectID string, reqEditors ...RequestEditorFn) (ResourceRules, error) {
+	resp, err := cli.ProjectDbaasConfigDepWithResponse(ctx, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return rulesOf(resp.OK), nil
+}
+
+func rulesOf(page *PagConfigDepSchema) ResourceRules {
+	if page == nil || page.Result == nil {
+		return nil
+	}
+	return *page.Result
+}
+
+// Check reports every rule values violate as a *ValidationError keyed by resource
+// name, or nil. Rules naming a resource absent from values are skipped.
+func (r ResourceRules) Check(values ResourceValues) error {
+	v := &ValidationError{}
+	for _, rule := range r {
+		target, ok := values[rule.Target]
+		if !ok {
+			continue
+		}
+		if !inTargetRange(rule, target) {
+			v.add(rule.Target, fmt.Sprintf("%d is outside the allowed range [%d, %d]",
+				target, rule.MinTargetValue, rule.MaxTargetValue))
+			continue
+		}
+		dependent, ok := values[rule.Dependent]
+		if !ok {
+			continue
+		}
+		if need, found := minDependent(rule.Dependencies, target); found && dependent < need {
+			v.add(rule.Dependent, fmt.Sprintf("%s=%d requires %s >= %d, got %d",
+				rule.Target, target, rule.Dependent, need, dependent))
+		}
+	}
+	return v.errOrNil()
+}
+
+// Valid reports whether values satisfy every rule.
+func (r ResourceRules) Valid(values ResourceValues) bool {
+	return r.Check(values) == nil
+}
+
+// Nearest returns the smallest valid combination that is at least want in every
+// resource: targets below their minimum are raised to it, and dependents are
+// raised to what their targets require. It fails if a resource exceeds its
+// maximum, since no valid flavor is large enough.
+func (r ResourceRules) Nearest(want ResourceValues) (ResourceValues, error) {
+	got := make(ResourceValues, len(want))
+	for k, v := range want {
+		got[k] = v
+	}
+	// Raising one resource can raise another it constrains; iterate to a fixpoint.
+	// Values only grow and are capped by the rules, so len(r)+1 passes suffice.
+	for pass := 0; pass <= len(r); pass++ {
+		changed := false
+		for _, rule := range r {
+			target, ok := got[rule.Target]
+			if !ok {
+				continue
+			}
+			if rule.MaxTargetValue > 0 && target > rule.MaxTargetValue {
+				return nil, fmt.Errorf("cloapi: %s=%d exceeds the maximum of %d", rule.Target, target, rule.MaxTargetValue)
+			}
+			if target < rule.MinTargetValue {
+				got[rule.Target], target, changed = rule.MinTargetValue, rule.MinTargetValue, true
+			}
+			dependent, ok := got[rule.Dependent]
+			if !ok {
+				continue
+			}
+			if need, found := minDependent(rule.Dependencies, target); found && dependent < need {
+				got[rule.Dependent], changed = need, true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	if err := r.Check(got); err != nil {
+		return nil, err
+	}
+	return got, nil
+}
+
+// Range is an inclusive interval of valid amounts. A zero Max is unbounded.
+type Range struct {
+	Min, Max int
+}
+
+// Contains reports whether v lies in the range.
+func (rg Range) Contains(v int) bool {
+	return v >= rg.Min && (rg.Max == 0 || v <= rg.Max)
+}
+
+// Bounds returns the valid range of resource given the amounts already fixed, e.g.
+// the RAM range for 4 vCPUs. It fails if the fixed amounts leave no valid value.
+func (r ResourceRules) Bounds(resource string, fixed ResourceValues) (Range, error) {
+	var rg Range
+	for _, rule := range r {
+		switch resource {
+		case rule.Target:
+			rg.Min = max(rg.Min, rule.MinTargetValue)
+			if rule.MaxTargetValue > 0 && (rg.Max == 0 || rule.MaxTargetValue < rg.Max) {
+				rg.Max = rule.MaxTargetValue
+			}
+		case rule.Dependent:
+			target, ok := fixed[rule.Target]
+			if !ok {
+				continue
+			}
+			if need, found := minDependent(rule.Dependencies, target); found {
+				rg.Min = max(rg.Min, need)
+			}
+		}
+	}
+	if rg.Max > 0 && rg.Min > rg.Max {
+		return rg, fmt.Errorf("cloapi: no valid %s for %v", resource, fixed)
+	}
+	return rg, nil
+}
+
+// ValidValues lists the valid amounts of resource given the fixed amounts, in
+// steps of step starting at the lower bound. The resource must be bounded above.
+func (r ResourceRules) ValidValues(resource string, fixed ResourceValues, step int) ([]int, error) {
+	rg, err := r.Bounds(resource, fixed)
+	if err != nil {
+		return nil, err
+	}
+	if rg.Max == 0 {
+		return nil, fmt.Errorf("cloapi: %s has no upper bound", resource)
+	}
+	if step <= 0 {
+		step = 1
+	}
+	var values []int
+	for v := rg.Min; v <= rg.Max; v += step {
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// Breakpoints returns the target values at which a rule's requirements change,
+// sorted ascending. UIs use them as the natural flavor steps of a resource.
+func (r ResourceRules) Breakpoints(resource string) []int {
+	seen := map[int]bool{}
+	var points []int
+	for _, rule := range r {
+		if rule.Target != resource {
+			continue
+		}
+		for _, d := range rule.Dependencies {
+			if !seen[d.Value] && inTargetRange(rule, d.Value) {
+				seen[d.Value] = true
+				points = append(points, d.Value)
+			}
+		}
+	}
+	sort.Ints(points)
+	return points
+}
+
+func inTargetRange(rule ConfigDepSchema, v int) bool {
+	return Range{Min: rule.MinTargetValue, Max: rule.MaxTargetValue}.Contains(v)
+}
+
+// minDependent returns the MinDependent of the dependency with the largest Value
+// not above target.
+func minDependent(deps []Dependendcies, target int) (int, bool) {
+	best, bestValue, found := 0, 0, false
+	for _, d := range deps {
+		if d.Value <= target && (!found || d.Value > bestValue) {
+			best, bestValue, found = d.MinDependent, d.Value, true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,121 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// testRules: vcpus in [1, 16], 4+ vcpus need 8+ ram; ram in [1, 64], 32+ ram needs
+// a 40+ disk.
+var testRules = ResourceRules{
+	{
+		Target: ResourceVcpus, Dependent: ResourceRAM, MinTargetValue: 1, MaxTargetValue: 16,
+		Dependencies: []Dependendcies{{Value: 1, MinDependent: 1}, {Value: 4, MinDependent: 8}, {Value: 8, MinDependent: 16}},
+	},
+	{
+		Target: ResourceRAM, Dependent: ResourceDisk, MinTargetValue: 1, MaxTargetValue: 64,
+		Dependencies: []Dependendcies{{Value: 1, MinDependent: 10}, {Value: 32, MinDependent: 40}},
+	},
+}
+
+func TestResourceRulesCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		values ResourceValues
+		bad    []string
+	}{
+		{"valid", ResourceValues{ResourceVcpus: 4, ResourceRAM: 8, ResourceDisk: 10}, nil},
+		{"ram too low", ResourceValues{ResourceVcpus: 4, ResourceRAM: 4}, []string{ResourceRAM}},
+		{"vcpus out of range", ResourceValues{ResourceVcpus: 32, ResourceRAM: 64}, []string{ResourceVcpus}},
+		{"disk too low", ResourceValues{ResourceVcpus: 8, ResourceRAM: 32, ResourceDisk: 20}, []string{ResourceDisk}},
+		{"missing resources skipped", ResourceValues{ResourceVcpus: 2}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testRules.Check(tt.values)
+			if tt.bad == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("err = %v, want *ValidationError", err)
+			}
+			var got []string
+			for _, f := range verr.Fields {
+				got = append(got, f.Field)
+			}
+			if !reflect.DeepEqual(got, tt.bad) {
+				t.Errorf("fields = %v, want %v", got, tt.bad)
+			}
+		})
+	}
+}
+
+func TestResourceRulesNearest(t *testing.T) {
+	got, err := testRules.Nearest(ResourceValues{ResourceVcpus: 8, ResourceRAM: 2, ResourceDisk: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ResourceValues{ResourceVcpus: 8, ResourceRAM: 16, ResourceDisk: 10}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Nearest = %v, want %v", got, want)
+	}
+
+	if _, err := testRules.Nearest(ResourceValues{ResourceVcpus: 17}); err == nil {
+		t.Fatal("want error above the maximum")
+	}
+}
+
+func TestResourceRulesBoundsAndValues(t *testing.T) {
+	rg, err := testRules.Bounds(ResourceRAM, ResourceValues{ResourceVcpus: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rg != (Range{Min: 8, Max: 64}) {
+		t.Fatalf("Bounds = %+v", rg)
+	}
+	values, err := testRules.ValidValues(ResourceRAM, ResourceValues{ResourceVcpus: 8}, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, []int{16, 32, 48, 64}) {
+		t.Fatalf("ValidValues = %v", values)
+	}
+	if _, err := testRules.ValidValues(ResourceDisk, nil, 1); err == nil {
+		t.Fatal("want error for a resource with no upper bound")
+	}
+	if got := testRules.Breakpoints(ResourceVcpus); !reflect.DeepEqual(got, []int{1, 4, 8}) {
+		t.Fatalf("Breakpoints = %v", got)
+	}
+}
+
+func TestLoadDbaasRules(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/projects/p1/dbaas/related-resources" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count":1,"result":[{"target":"vcpus","dependent":"ram","min_target_value":2,"max_target_value":8,"dependencies":[{"value":2,"min_dependent":4}]}]}`))
+	}))
+	defer srv.Close()
+
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, err := LoadDbaasRules(context.Background(), cli, "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules.Valid(ResourceValues{ResourceVcpus: 2, ResourceRAM: 2}) {
+		t.Fatal("2 vcpus / 2 ram should be invalid")
+	}
+}
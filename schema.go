@@ -0,0 +1,376 @@
+package cloapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// specValidator checks JSON values against the schemas of an OpenAPI document,
+// covering the keywords the generated models cannot express: minimum and
+// maximum, minLength and maxLength, pattern, format, item counts and enums on
+// untyped fields, on top of types, required fields and nullability.
+type specValidator struct {
+	root map[string]any
+	// bodies maps "METHOD path" to the operation's JSON request body schema.
+	bodies   map[string]map[string]any
+	patterns sync.Map // pattern -> *regexp.Regexp, nil if RE2 cannot compile it
+}
+
+// specValidation returns the validator of the embedded spec, nil when no spec
+// snapshot is embedded. A variable so tests can swap it.
+var specValidation = sync.OnceValue(func() *specValidator {
+	return newSpecValidator(OpenAPISpec())
+})
+
+// newSpecValidator indexes the request body schemas of doc, an OpenAPI
+// document. It returns nil if doc is empty or not JSON.
+func newSpecValidator(doc []byte) *specValidator {
+	if len(doc) == 0 {
+		return nil
+	}
+	var root map[string]any
+	if err := json.Unmarshal(doc, &root); err != nil {
+		return nil
+	}
+	s := &specValidator{root: root, bodies: map[string]map[string]any{}}
+	for path, item := range jsonObject(root["paths"]) {
+		for method, op := range jsonObject(item) {
+			body := s.resolve(jsonObject(jsonObject(op)["requestBody"]))
+			content := jsonObject(body["content"])
+			if schema := jsonObject(jsonObject(content["application/json"])["schema"]); schema != nil {
+				s.bodies[strings.ToUpper(method)+" "+path] = schema
+			}
+		}
+	}
+	return s
+}
+
+// bodySchema returns the JSON request body schema of op.
+func (s *specValidator) bodySchema(op Operation) (map[string]any, bool) {
+	if s == nil {
+		return nil, false
+	}
+	schema, ok := s.bodies[op.Method+" "+op.Path]
+	return schema, ok
+}
+
+// responseSchema returns the JSON schema of op's success response with the
+// given status, or of its first 2xx one as the generated Response does, nil if
+// the spec declares none.
+func (s *specValidator) responseSchema(op Operation, status int) map[string]any {
+	if s == nil {
+		return nil
+	}
+	responses := jsonObject(jsonObject(jsonObject(jsonObject(s.root["paths"])[op.Path])[strings.ToLower(op.Method)])["responses"])
+	for _, code := range []string{strconv.Itoa(status), "200", "201", "202"} {
+		resp := s.resolve(jsonObject(responses[code]))
+		if schema := jsonObject(jsonObject(jsonObject(resp["content"])["application/json"])["schema"]); schema != nil {
+			return schema
+		}
+	}
+	return nil
+}
+
+// objectShape returns the properties and required members of an object schema,
+// merging those of its allOf parts. fromSpec is false when the schema declares
+// neither, e.g. when s is nil or the schema is unknown.
+func (s *specValidator) objectShape(schema map[string]any) (props map[string]any, required []string, fromSpec bool) {
+	if s == nil || schema == nil {
+		return nil, nil, false
+	}
+	props = map[string]any{}
+	parts := append([]any{schema}, jsonList(schema["allOf"])...)
+	for _, part := range parts {
+		part := s.resolve(jsonObject(part))
+		_, hasProps := part["properties"]
+		_, hasRequired := part["required"]
+		fromSpec = fromSpec || hasProps || hasRequired
+		maps.Copy(props, jsonObject(part["properties"]))
+		required = append(required, jsonStrings(part["required"])...)
+	}
+	return props, required, fromSpec
+}
+
+// resolve follows $ref until it reaches a schema without one. References point
+// into the document, e.g. "#/components/schemas/ServerSchema".
+func (s *specValidator) resolve(schema map[string]any) map[string]any {
+	for range 32 {
+		ref, ok := schema["$ref"].(string)
+		if !ok {
+			return schema
+		}
+		var node any = s.root
+		for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			part = strings.NewReplacer("~1", "/", "~0", "~").Replace(part)
+			node = jsonObject(node)[part]
+		}
+		schema = jsonObject(node)
+	}
+	return nil
+}
+
+// validate checks the decoded JSON value doc against schema at pointer ptr.
+func (s *specValidator) validate(v *ValidationError, schema map[string]any, doc any, ptr string) {
+	schema = s.resolve(schema)
+	if schema == nil {
+		return
+	}
+	if doc == nil && schemaNullable(schema) {
+		return
+	}
+	allOf := jsonList(schema["allOf"])
+	for _, sub := range allOf {
+		s.validate(v, jsonObject(sub), doc, ptr)
+	}
+	alternatives := append(jsonList(schema["anyOf"]), jsonList(schema["oneOf"])...)
+	if len(alternatives) > 0 && !s.matchesAny(alternatives, doc, ptr) {
+		v.add(ptr, "matches none of the allowed schemas")
+	}
+	if doc == nil {
+		// allOf and the alternatives have judged null already; a schema without
+		// a type accepts anything.
+		if len(allOf) == 0 && len(alternatives) == 0 && len(schemaTypes(schema)) > 0 {
+			v.add(ptr, "must not be null")
+		}
+		return
+	}
+
+	if types := schemaTypes(schema); len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool { return hasJSONType(doc, t) }) {
+		v.add(ptr, "must be "+typeNames(types))
+		return
+	}
+	if enum := jsonList(schema["enum"]); len(enum) > 0 && !slices.ContainsFunc(enum, func(e any) bool { return reflect.DeepEqual(e, doc) }) {
+		raw, _ := json.Marshal(doc)
+		members, _ := json.Marshal(enum)
+		v.add(ptr, fmt.Sprintf("%s is not one of %s", raw, members))
+	}
+
+	switch d := doc.(type) {
+	case map[string]any:
+		props := jsonObject(schema["properties"])
+		for _, name := range jsonStrings(schema["required"]) {
+			if _, ok := d[name]; !ok {
+				v.add(ptr+"/"+escapePointer(name), "required field is missing")
+			}
+		}
+		extra := jsonObject(schema["additionalProperties"])
+		for _, name := range slices.Sorted(maps.Keys(d)) {
+			switch sub := jsonObject(props[name]); {
+			case sub != nil:
+				s.validate(v, sub, d[name], ptr+"/"+escapePointer(name))
+			case extra != nil:
+				s.validate(v, extra, d[name], ptr+"/"+escapePointer(name))
+			}
+		}
+	case []any:
+		if n, ok := schemaNumber(schema, "minItems"); ok && float64(len(d)) < n {
+			v.add(ptr, fmt.Sprintf("must have at least %v items", n))
+		}
+		if n, ok := schemaNumber(schema, "maxItems"); ok && float64(len(d)) > n {
+			v.add(ptr, fmt.Sprintf("must have at most %v items", n))
+		}
+		if items := jsonObject(schema["items"]); items != nil {
+			for i, el := range d {
+				s.validate(v, items, el, ptr+"/"+strconv.Itoa(i))
+			}
+		}
+	case string:
+		length := float64(utf8.RuneCountInString(d))
+		if n, ok := schemaNumber(schema, "minLength"); ok && length < n {
+			v.add(ptr, fmt.Sprintf("must be at least %v characters long", n))
+		}
+		if n, ok := schemaNumber(schema, "maxLength"); ok && length > n {
+			v.add(ptr, fmt.Sprintf("must be at most %v characters long", n))
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re := s.pattern(pattern); re != nil && !re.MatchString(d) {
+				v.add(ptr, fmt.Sprintf("must match %q", pattern))
+			}
+		}
+		if format, ok := schema["format"].(string); ok && !validFormat(format, d) {
+			v.add(ptr, "must be a valid "+format)
+		}
+	case float64:
+		s.validateNumber(v, schema, d, ptr)
+	}
+}
+
+func (s *specValidator) validateNumber(v *ValidationError, schema map[string]any, n float64, ptr string) {
+	// OpenAPI 3.0 marks a bound exclusive with a boolean, 3.1 gives the bound.
+	if bound, ok := schemaNumber(schema, "minimum"); ok {
+		if schema["exclusiveMinimum"] == true && n <= bound {
+			v.add(ptr, fmt.Sprintf("must be greater than %v", bound))
+		} else if n < bound {
+			v.add(ptr, fmt.Sprintf("must be at least %v", bound))
+		}
+	}
+	if bound, ok := schemaNumber(schema, "exclusiveMinimum"); ok && n <= bound {
+		v.add(ptr, fmt.Sprintf("must be greater than %v", bound))
+	}
+	if bound, ok := schemaNumber(schema, "maximum"); ok {
+		if schema["exclusiveMaximum"] == true && n >= bound {
+			v.add(ptr, fmt.Sprintf("must be less than %v", bound))
+		} else if n > bound {
+			v.add(ptr, fmt.Sprintf("must be at most %v", bound))
+		}
+	}
+	if bound, ok := schemaNumber(schema, "exclusiveMaximum"); ok && n >= bound {
+		v.add(ptr, fmt.Sprintf("must be less than %v", bound))
+	}
+}
+
+// matchesAny reports whether doc is valid against one of the schemas.
+func (s *specValidator) matchesAny(schemas []any, doc any, ptr string) bool {
+	for _, alt := range schemas {
+		probe := &ValidationError{}
+		s.validate(probe, jsonObject(alt), doc, ptr)
+		if len(probe.Fields) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// pattern compiles an ECMA-262 pattern as RE2. Patterns RE2 does not support,
+// such as lookaheads, are not checked.
+func (s *specValidator) pattern(p string) *regexp.Regexp {
+	if re, ok := s.patterns.Load(p); ok {
+		return re.(*regexp.Regexp)
+	}
+	re, _ := regexp.Compile(p)
+	s.patterns.Store(p, re)
+	return re
+}
+
+// schemaTypes returns the non-null types a schema allows; OpenAPI 3.1 may list
+// several.
+func schemaTypes(schema map[string]any) []string {
+	var types []string
+	switch t := schema["type"].(type) {
+	case string:
+		types = []string{t}
+	case []any:
+		types = jsonStrings(t)
+	}
+	return slices.DeleteFunc(types, func(t string) bool { return t == "null" })
+}
+
+func schemaNullable(schema map[string]any) bool {
+	if schema["nullable"] == true {
+		return true
+	}
+	switch t := schema["type"].(type) {
+	case string:
+		return t == "null"
+	case []any:
+		return slices.Contains(t, any("null"))
+	}
+	return false
+}
+
+func hasJSONType(doc any, t string) bool {
+	switch t {
+	case "object":
+		_, ok := doc.(map[string]any)
+		return ok
+	case "array":
+		_, ok := doc.([]any)
+		return ok
+	case "string":
+		_, ok := doc.(string)
+		return ok
+	case "boolean":
+		_, ok := doc.(bool)
+		return ok
+	case "number":
+		_, ok := doc.(float64)
+		return ok
+	case "integer":
+		n, ok := doc.(float64)
+		return ok && n == math.Trunc(n)
+	}
+	return true
+}
+
+var typeArticles = map[string]string{
+	"object": "an object", "array": "an array", "string": "a string",
+	"boolean": "a boolean", "number": "a number", "integer": "an integer",
+}
+
+func typeNames(types []string) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = typeArticles[t]
+		if names[i] == "" {
+			names[i] = t
+		}
+	}
+	return strings.Join(names, " or ")
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validFormat checks the string formats the CLO spec uses; others pass.
+func validFormat(format, s string) bool {
+	switch format {
+	case "uuid":
+		return uuidPattern.MatchString(s)
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	case "date":
+		_, err := time.Parse(time.DateOnly, s)
+		return err == nil
+	case "email":
+		_, err := mail.ParseAddress(s)
+		return err == nil
+	case "ipv4":
+		addr, err := netip.ParseAddr(s)
+		return err == nil && addr.Is4()
+	case "ipv6":
+		addr, err := netip.ParseAddr(s)
+		return err == nil && addr.Is6()
+	case "uri":
+		u, err := url.Parse(s)
+		return err == nil && u.Scheme != ""
+	}
+	return true
+}
+
+func schemaNumber(schema map[string]any, key string) (float64, bool) {
+	n, ok := schema[key].(float64)
+	return n, ok
+}
+
+func jsonObject(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+func jsonList(v any) []any {
+	l, _ := v.([]any)
+	return l
+}
+
+func jsonStrings(v any) []string {
+	var out []string
+	for _, it := range jsonList(v) {
+		if s, ok := it.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
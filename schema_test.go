@@ -0,0 +1,198 @@
+package cloapi
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const testSpec = `{
+  "openapi": "3.0.3",
+  "paths": {
+    "/v2/projects/{object_id}/loadbalancers": {"post": {
+      "operationId": "LoadBalancerCreate",
+      "requestBody": {"$ref": "#/components/requestBodies/LoadBalancerCreate"}
+    }}
+  },
+  "components": {
+    "requestBodies": {
+      "LoadBalancerCreate": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/LoadBalancerCreate"}}}}
+    },
+    "schemas": {
+      "LoadBalancerCreate": {
+        "type": "object",
+        "required": ["name", "healthmonitor"],
+        "properties": {
+          "name": {"type": "string", "minLength": 3, "maxLength": 8, "pattern": "^[a-z][a-z0-9-]*$"},
+          "address_id": {"type": "string", "format": "uuid", "nullable": true},
+          "algorithm": {"enum": ["ROUND_ROBIN", "LEAST_CONNECTIONS"]},
+          "healthmonitor": {"$ref": "#/components/schemas/Healthmonitor"},
+          "rules": {"type": "array", "maxItems": 1, "items": {
+            "type": "object",
+            "properties": {"external_protocol_port": {"type": "integer", "minimum": 1, "maximum": 65535}}
+          }},
+          "session_persistence": {"oneOf": [{"type": "boolean"}, {"type": "string", "enum": ["SOURCE_IP"]}]}
+        }
+      },
+      "Healthmonitor": {
+        "type": "object",
+        "required": ["delay"],
+        "properties": {
+          "delay": {"type": "integer", "minimum": 0, "exclusiveMinimum": true},
+          "created": {"type": "string", "format": "date-time"}
+        }
+      }
+    }
+  }
+}`
+
+// withSpec validates against doc, as if it were the embedded spec, until the
+// test ends.
+func withSpec(t *testing.T, doc string) {
+	t.Helper()
+	s := newSpecValidator([]byte(doc))
+	if s == nil {
+		t.Fatal("spec not loaded")
+	}
+	old := specValidation
+	specValidation = func() *specValidator { return s }
+	t.Cleanup(func() { specValidation = old })
+}
+
+// withoutSpec validates against the generated models until the test ends, as
+// in a checkout without a spec snapshot.
+func withoutSpec(t *testing.T) {
+	t.Helper()
+	old := specValidation
+	specValidation = func() *specValidator { return nil }
+	t.Cleanup(func() { specValidation = old })
+}
+
+func TestValidateRequestBodyAgainstSpec(t *testing.T) {
+	withSpec(t, testSpec)
+	body := []byte(`{
+		"name": "Load_balancer",
+		"address_id": "not-a-uuid",
+		"algorithm": "FASTEST",
+		"healthmonitor": {"delay": 0, "created": "yesterday"},
+		"rules": [{"external_protocol_port": 70000}, {"external_protocol_port": 0}],
+		"session_persistence": "COOKIE"
+	}`)
+	got := fieldsOf(t, ValidateRequestBody("LoadBalancerCreate", body))
+	want := []string{
+		"/address_id: must be a valid uuid",
+		`/algorithm: "FASTEST" is not one of ["ROUND_ROBIN","LEAST_CONNECTIONS"]`,
+		"/healthmonitor/created: must be a valid date-time",
+		"/healthmonitor/delay: must be greater than 0",
+		"/name: must be at most 8 characters long",
+		`/name: must match "^[a-z][a-z0-9-]*$"`,
+		"/rules: must have at most 1 items",
+		"/rules/0/external_protocol_port: must be at most 65535",
+		"/rules/1/external_protocol_port: must be at least 1",
+		"/session_persistence: matches none of the allowed schemas",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("violations:\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestValidateRequestBodyAgainstSpecValid(t *testing.T) {
+	withSpec(t, testSpec)
+	for _, body := range []string{
+		`{"name":"lb-1","healthmonitor":{"delay":5},"address_id":null,"session_persistence":true}`,
+		`{"name":"web","healthmonitor":{"delay":1,"created":"2026-10-19T12:00:00Z"},"session_persistence":"SOURCE_IP","extra":1}`,
+	} {
+		if err := ValidateRequestBody("LoadBalancerCreate", []byte(body)); err != nil {
+			t.Errorf("%s: %v", body, err)
+		}
+	}
+
+	got := fieldsOf(t, ValidateRequestBody("LoadBalancerCreate", []byte(`{"name":null,"healthmonitor":{"delay":"5"}}`)))
+	want := []string{"/healthmonitor/delay: must be an integer", "/name: must not be null"}
+	if !slices.Equal(got, want) {
+		t.Errorf("violations = %q, want %q", got, want)
+	}
+
+	// Operations the spec gives no JSON body fall back to the model, here none.
+	if err := ValidateRequestBody("ServerReboot", []byte(`{"anything":1}`)); err != nil {
+		t.Errorf("operation without body schema: %v", err)
+	}
+}
+
+func TestWithRequestValidationUsesSpec(t *testing.T) {
+	withSpec(t, testSpec)
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"result":{"id":"lb1"}}`))
+	}))
+	defer srv.Close()
+	cli, err := New("tok", WithBaseURL(srv.URL), WithRequestValidation())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The model accepts any name; the spec limits its length.
+	body := LoadBalancerCreateJSONRequestBody{Name: "much-too-long"}
+	body.Healthmonitor.Delay = 5
+	_, err = cli.LoadBalancerCreateWithResponse(context.Background(), "p1", body)
+	if got := fieldsOf(t, err); !slices.Contains(got, "/name: must be at most 8 characters long") {
+		t.Errorf("violations = %q", got)
+	}
+	if calls.Load() != 0 {
+		t.Error("invalid body was sent")
+	}
+}
+
+// TestEmbeddedSpecValidation runs against the spec a default build embeds,
+// without build tags: a body breaking a maximum or a pattern of a request
+// schema must be caught, which the model fallback cannot do.
+func TestEmbeddedSpecValidation(t *testing.T) {
+	if OpenAPISpec() == nil {
+		t.Skip("no spec/openapi.snapshot.json in this checkout; make generate writes it")
+	}
+	s := specValidation()
+	if s == nil {
+		t.Fatal("embedded spec not loaded")
+	}
+	for _, op := range Operations {
+		schema, ok := s.bodySchema(op)
+		if !ok {
+			continue
+		}
+		props := jsonObject(s.resolve(schema)["properties"])
+		for _, name := range slices.Sorted(maps.Keys(props)) {
+			prop := s.resolve(jsonObject(props[name]))
+			var bad any
+			if bound, ok := schemaNumber(prop, "maximum"); ok {
+				bad = bound + 1
+			} else if p, ok := prop["pattern"].(string); ok && s.pattern(p) != nil {
+				for _, candidate := range []string{"", " ", "\x00", "!!", "-1"} {
+					if !s.pattern(p).MatchString(candidate) {
+						bad = candidate
+						break
+					}
+				}
+			}
+			if bad == nil {
+				continue
+			}
+			body, _ := json.Marshal(map[string]any{name: bad})
+			for _, f := range fieldsOf(t, ValidateRequestBody(op.ID, body)) {
+				if strings.HasPrefix(f, "/"+name+": must be at most") || strings.HasPrefix(f, "/"+name+": must be less than") ||
+					strings.HasPrefix(f, "/"+name+": must match") {
+					return
+				}
+			}
+			t.Fatalf("%s: %s passed the spec's bounds of %s", op.ID, body, name)
+		}
+	}
+	t.Skip("the embedded spec bounds no request body property with maximum or pattern")
+}
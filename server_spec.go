@@ -0,0 +1,290 @@
+package cloapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ServerSpec builds a ServerCreateJSONRequestBody fluently and validates it locally,
+// so invalid combinations surface before the request is sent instead of as a 400.
+// The generated body keeps its pointers and inlined structs; the builder only hides
+// them. Example:
+//
+//	body, err := cloapi.NewServerSpec("web-1").
+//		Flavor(2, 4).
+//		FromImage(imageID).
+//		Storage(20, "volume", true).
+//		Keypairs(keyID).
+//		ExternalAddress(false, nil).
+//		Build()
+type ServerSpec struct {
+	body ServerCreateJSONRequestBody
+}
+
+// NewServerSpec starts a spec for a server with the given name.
+func NewServerSpec(name string) *ServerSpec {
+	return &ServerSpec{body: ServerCreateJSONRequestBody{Name: name}}
+}
+
+// Flavor sets the vCPU count and RAM size.
+func (s *ServerSpec) Flavor(vcpus, ram int) *ServerSpec {
+	s.body.Flavor.Vcpus = vcpus
+	s.body.Flavor.Ram = ram
+	return s
+}
+
+// CPUType sets the optional CPU type of the flavor.
+func (s *ServerSpec) CPUType(cpuType string) *ServerSpec {
+	s.body.Flavor.CpuType = &cpuType
+	return s
+}
+
+// FromImage boots the server from an image ID.
+func (s *ServerSpec) FromImage(imageID string) *ServerSpec {
+	s.body.Image = &imageID
+	return s
+}
+
+// FromVolume boots the server from an existing volume ID.
+func (s *ServerSpec) FromVolume(volumeID string) *ServerSpec {
+	s.body.Volume = &volumeID
+	return s
+}
+
+// FromRecipe boots the server from a recipe ID.
+func (s *ServerSpec) FromRecipe(recipeID string) *ServerSpec {
+	s.body.Recipe = &recipeID
+	return s
+}
+
+// Storage adds a storage of size GB. An empty storageType leaves the API default.
+func (s *ServerSpec) Storage(size int, storageType string, bootable bool) *ServerSpec {
+	st := appendElem(&s.body.Storages)
+	st.Size = size
+	st.Bootable = &bootable
+	if storageType != "" {
+		st.StorageType = &storageType
+	}
+	return s
+}
+
+// Keypairs adds keypair IDs whose public keys are injected into the server.
+func (s *ServerSpec) Keypairs(ids ...string) *ServerSpec {
+	if s.body.Keypairs == nil {
+		s.body.Keypairs = &[]string{}
+	}
+	*s.body.Keypairs = append(*s.body.Keypairs, ids...)
+	return s
+}
+
+// UserData sets the cloud-init user data passed to the server on first boot; build
+// it with NewUserData.
+func (s *ServerSpec) UserData(data string) *ServerSpec {
+	s.body.UserData = &data
+	return s
+}
+
+// ExternalAddress requests a new external address. A nil bandwidth leaves the API
+// default.
+func (s *ServerSpec) ExternalAddress(ddosProtection bool, bandwidth *ServerCreateJSONBodyAddressesBandwidthMaxMbps) *ServerSpec {
+	a := appendElem(&s.body.Addresses)
+	external := true
+	a.External = &external
+	a.DdosProtection = &ddosProtection
+	a.BandwidthMaxMbps = bandwidth
+	return s
+}
+
+// ExistingAddress attaches an already allocated address by ID.
+func (s *ServerSpec) ExistingAddress(addressID string) *ServerSpec {
+	a := appendElem(&s.body.Addresses)
+	a.AddressId = &addressID
+	return s
+}
+
+// License adds a license with the given name and addon.
+func (s *ServerSpec) License(name, addon string) *ServerSpec {
+	l := appendElem(&s.body.Licenses)
+	l.Name = name
+	l.Addon = addon
+	return s
+}
+
+// ServerLimits holds the project-level constraints ValidateWith checks a spec
+// against. Load it once per project with LoadServerLimits and reuse it.
+type ServerLimits struct {
+	// MaxAddressesPerServer caps the number of addresses (0 means unchecked).
+	MaxAddressesPerServer int
+	// Rules are the flavor dependency rules from ProjectServerConfigDep.
+	Rules ResourceRules
+}
+
+// LoadServerLimits fetches the infrastructure constants and server flavor rules of
+// a project.
+func LoadServerLimits(ctx context.Context, cli ClientWithResponsesInterface, projectID string, reqEditors ...RequestEditorFn) (ServerLimits, error) {
+	var limits ServerLimits
+
+	consts, err := cli.ProjectInfrastructureModuleConstantsWithResponse(ctx, projectID, reqEditors...)
+	if err != nil {
+		return limits, err
+	}
+	if consts.OK != nil {
+		limits.MaxAddressesPerServer = consts.OK.MaxAddressesPerServer
+	}
+
+	limits.Rules, err = LoadServerRules(ctx, cli, projectID, reqEditors...)
+	return limits, err
+}
+
+// Validate checks the spec locally and returns every violation at once as a
+// *ValidationError, or nil.
+func (s *ServerSpec) Validate() error {
+	return s.validate(nil)
+}
+
+// ValidateWith is Validate plus the project limits: the address count and the
+// flavor dependency rules.
+func (s *ServerSpec) ValidateWith(limits ServerLimits) error {
+	return s.validate(&limits)
+}
+
+// Build validates the spec locally and returns the request body.
+func (s *ServerSpec) Build() (ServerCreateJSONRequestBody, error) {
+	if err := s.Validate(); err != nil {
+		return ServerCreateJSONRequestBody{}, err
+	}
+	return s.body, nil
+}
+
+// BuildWith validates the spec against limits and returns the request body.
+func (s *ServerSpec) BuildWith(limits ServerLimits) (ServerCreateJSONRequestBody, error) {
+	if err := s.ValidateWith(limits); err != nil {
+		return ServerCreateJSONRequestBody{}, err
+	}
+	return s.body, nil
+}
+
+func (s *ServerSpec) validate(limits *ServerLimits) error {
+	v := &ValidationError{}
+	b := &s.body
+
+	if strings.TrimSpace(b.Name) == "" {
+		v.add("name", "must not be empty")
+	}
+	if b.Flavor.Vcpus <= 0 {
+		v.add("flavor.vcpus", "must be positive")
+	}
+	if b.Flavor.Ram <= 0 {
+		v.add("flavor.ram", "must be positive")
+	}
+
+	var sources []string
+	for _, src := range []struct {
+		field string
+		value *string
+	}{{"image", b.Image}, {"volume", b.Volume}, {"recipe", b.Recipe}} {
+		if src.value == nil {
+			continue
+		}
+		sources = append(sources, src.field)
+		if *src.value == "" {
+			v.add(src.field, "must not be empty")
+		}
+	}
+	switch len(sources) {
+	case 0:
+		v.add("image", "exactly one boot source (image, volume or recipe) is required")
+	case 1:
+	default:
+		v.add(sources[1], fmt.Sprintf("conflicts with %s: exactly one boot source is allowed", sources[0]))
+	}
+
+	bootableDisk := 0
+	if b.Storages != nil {
+		bootable := 0
+		for i, st := range *b.Storages {
+			if st.Size <= 0 {
+				v.add(fmt.Sprintf("storages[%d].size", i), "must be positive")
+			}
+			if st.Bootable != nil && *st.Bootable {
+				bootable++
+				bootableDisk = st.Size
+				if bootable > 1 {
+					v.add(fmt.Sprintf("storages[%d].bootable", i), "at most one storage may be bootable")
+				}
+			}
+		}
+	}
+
+	if b.Keypairs != nil {
+		seen := map[string]bool{}
+		for i, id := range *b.Keypairs {
+			switch {
+			case id == "":
+				v.add(fmt.Sprintf("keypairs[%d]", i), "must not be empty")
+			case seen[id]:
+				v.add(fmt.Sprintf("keypairs[%d]", i), fmt.Sprintf("duplicate keypair %s", id))
+			}
+			seen[id] = true
+		}
+	}
+	if b.UserData != nil && *b.UserData == "" {
+		v.add("user_data", "must not be empty when set")
+	}
+	if b.UserData != nil && len(*b.UserData) > MaxUserDataSize {
+		v.add("user_data", fmt.Sprintf("is %d bytes, over the %d byte limit", len(*b.UserData), MaxUserDataSize))
+	}
+	if b.Addresses != nil {
+		for i, a := range *b.Addresses {
+			if a.AddressId != nil && a.External != nil && *a.External {
+				v.add(fmt.Sprintf("addresses[%d]", i), "address_id and external are mutually exclusive")
+			}
+			if a.BandwidthMaxMbps != nil && !a.BandwidthMaxMbps.Valid() {
+				v.add(fmt.Sprintf("addresses[%d].bandwidth_max_mbps", i), fmt.Sprintf("unsupported value %d", *a.BandwidthMaxMbps))
+			}
+		}
+	}
+	if b.Licenses != nil {
+		for i, l := range *b.Licenses {
+			if l.Name == "" {
+				v.add(fmt.Sprintf("licenses[%d].name", i), "must not be empty")
+			}
+		}
+	}
+
+	if limits != nil {
+		if n := len(deref(b.Addresses)); limits.MaxAddressesPerServer > 0 && n > limits.MaxAddressesPerServer {
+			v.add("addresses", fmt.Sprintf("%d addresses exceed the project maximum of %d per server", n, limits.MaxAddressesPerServer))
+		}
+		values := ResourceValues{ResourceVcpus: b.Flavor.Vcpus, ResourceRAM: b.Flavor.Ram}
+		if bootableDisk > 0 {
+			values[ResourceDisk] = bootableDisk
+		}
+		if err, ok := limits.Rules.Check(values).(*ValidationError); ok {
+			for _, f := range err.Fields {
+				v.add("flavor."+f.Field, f.Reason)
+			}
+		}
+	}
+
+	return v.errOrNil()
+}
+
+// appendElem appends a zero element to the optional slice *s, allocating it if
+// needed, and returns a pointer to the new element. It lets the builders fill the
+// generated inlined anonymous structs without spelling out their types.
+func appendElem[T any](s **[]T) *T {
+	if *s == nil {
+		*s = &[]T{}
+	}
+	**s = append(**s, *new(T))
+	return &(**s)[len(**s)-1]
+}
+
+func deref[T any](p *[]T) []T {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
@@ -0,0 +1,145 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func fieldSet(t *testing.T, err error) map[string]bool {
+	t.Helper()
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("err = %v, want *ValidationError", err)
+	}
+	fields := map[string]bool{}
+	for _, f := range verr.Fields {
+		fields[f.Field] = true
+	}
+	return fields
+}
+
+func TestServerSpecBuildValid(t *testing.T) {
+	bw := ServerCreateJSONBodyAddressesBandwidthMaxMbpsN100
+	body, err := NewServerSpec("web-1").
+		Flavor(2, 4).
+		FromImage("img-1").
+		Storage(20, "volume", true).
+		Storage(50, "", false).
+		Keypairs("key-1").
+		ExternalAddress(true, &bw).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body.Name != "web-1" || body.Flavor.Vcpus != 2 || body.Flavor.Ram != 4 {
+		t.Errorf("unexpected body: %+v", body)
+	}
+	if body.Image == nil || *body.Image != "img-1" {
+		t.Errorf("image = %v", body.Image)
+	}
+	if len(*body.Storages) != 2 || (*body.Storages)[1].StorageType != nil {
+		t.Errorf("storages = %+v", *body.Storages)
+	}
+	if a := (*body.Addresses)[0]; !*a.External || *a.BandwidthMaxMbps != 100 {
+		t.Errorf("address = %+v", a)
+	}
+}
+
+func TestServerSpecReportsAllErrors(t *testing.T) {
+	_, err := NewServerSpec("").
+		FromImage("img-1").
+		FromVolume("vol-1").
+		Storage(10, "", true).
+		Storage(10, "", true).
+		Keypairs("k", "k").
+		Build()
+	fields := fieldSet(t, err)
+	for _, want := range []string{"name", "flavor.vcpus", "flavor.ram", "volume", "storages[1].bootable", "keypairs[1]"} {
+		if !fields[want] {
+			t.Errorf("missing error for %q in %v", want, err)
+		}
+	}
+}
+
+func TestServerSpecFromVolumeWithKeysAndUserData(t *testing.T) {
+	// The spec does not restrict keypairs and user_data to image boots, so the
+	// ServerSpec does not either.
+	body, err := NewServerSpec("restored").
+		Flavor(2, 4).
+		FromVolume("vol-1").
+		Keypairs("key-1").
+		UserData("#cloud-config\n").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *body.Volume != "vol-1" || len(*body.Keypairs) != 1 || body.UserData == nil {
+		t.Errorf("body = %+v", body)
+	}
+}
+
+func TestServerSpecRequiresBootSource(t *testing.T) {
+	err := NewServerSpec("x").Flavor(1, 1).Validate()
+	if !fieldSet(t, err)["image"] {
+		t.Fatalf("want boot source error, got %v", err)
+	}
+}
+
+func TestServerSpecValidateWithLimits(t *testing.T) {
+	limits := ServerLimits{
+		MaxAddressesPerServer: 1,
+		Rules: ResourceRules{{
+			Target: "vcpus", Dependent: "ram", MinTargetValue: 1, MaxTargetValue: 16,
+			Dependencies: []Dependendcies{{Value: 1, MinDependent: 1}, {Value: 4, MinDependent: 8}},
+		}},
+	}
+	spec := NewServerSpec("x").Flavor(4, 4).FromImage("img").ExternalAddress(false, nil).ExistingAddress("a-1")
+	if err := spec.Validate(); err != nil {
+		t.Fatalf("local validation should pass: %v", err)
+	}
+	err := spec.ValidateWith(limits)
+	fields := fieldSet(t, err)
+	if !fields["addresses"] || !fields["flavor.ram"] {
+		t.Fatalf("want addresses and flavor errors, got %v", err)
+	}
+
+	ok := NewServerSpec("x").Flavor(4, 8).FromImage("img")
+	if _, err := ok.BuildWith(limits); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := NewServerSpec("x").Flavor(32, 64).FromImage("img").ValidateWith(limits); err == nil ||
+		!strings.Contains(err.Error(), "outside the allowed range") {
+		t.Fatalf("want range error, got %v", err)
+	}
+}
+
+func TestLoadServerLimits(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/projects/p1/params":
+			_, _ = w.Write([]byte(`{"max_addresses_per_server":3}`))
+		case "/v2/projects/p1/servers/related-resources":
+			_, _ = w.Write([]byte(`{"count":1,"result":[{"target":"vcpus","dependent":"ram","min_target_value":1,"max_target_value":8,"dependencies":[]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	limits, err := LoadServerLimits(context.Background(), cli, "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if limits.MaxAddressesPerServer != 3 || len(limits.Rules) != 1 {
+		t.Fatalf("limits = %+v", limits)
+	}
+}
@@ -0,0 +1,14 @@
+package cloapi
+
+import "embed"
+
+// specFiles holds spec/openapi.snapshot.json, which make generate writes next to
+// clo_gen.go from the same spec. The directory is embedded rather than the file
+// so that a checkout without a snapshot still builds, with a nil OpenAPISpec.
+//
+//go:embed spec
+var specFiles embed.FS
+
+func init() {
+	openAPISpec, _ = specFiles.ReadFile("spec/openapi.snapshot.json")
+}
@@ -0,0 +1,188 @@
+package cloapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+)
+
+// ListStream decodes a {count, result} envelope one item at a time, so a large
+// listing is never held in memory as a whole, neither as bytes nor as a slice.
+// Items are decoded with the JSONCodec set by SetJSONCodec.
+type ListStream[T any] struct {
+	rsp   *http.Response
+	dec   *json.Decoder
+	count int
+	// inResult is true while the decoder sits inside the result array.
+	inResult bool
+	used     bool
+}
+
+// StreamList starts decoding the response of a raw generated call, which it
+// takes together with the call's error:
+//
+//	stream, err := cloapi.StreamList[cloapi.Stat](cli.AccountStat(ctx))
+//	if err != nil {
+//		return err
+//	}
+//	defer stream.Close()
+//	for stat, err := range stream.All() {
+//		...
+//	}
+//
+// A non-2xx status is returned as *ApiError, as from the *WithResponse methods.
+// The envelope is read up to the start of result, so Count is known from the
+// start when the API sends count first, as it does.
+func StreamList[T any](rsp *http.Response, err error) (*ListStream[T], error) {
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		defer func() { _ = rsp.Body.Close() }()
+		body, err := io.ReadAll(rsp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, apiErrorOf(rsp, body)
+	}
+
+	s := &ListStream[T]{rsp: rsp, dec: json.NewDecoder(rsp.Body)}
+	if err := s.open(); err != nil {
+		_ = rsp.Body.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// open consumes the envelope up to the first result item, or to its end.
+func (s *ListStream[T]) open() error {
+	if err := s.expect(json.Delim('{')); err != nil {
+		return err
+	}
+	return s.fields()
+}
+
+// fields reads envelope fields until it enters result or the envelope ends.
+func (s *ListStream[T]) fields() error {
+	for s.dec.More() {
+		tok, err := s.dec.Token()
+		if err != nil {
+			return s.fail(err)
+		}
+		switch tok {
+		case "count":
+			if err := s.dec.Decode(&s.count); err != nil {
+				return s.fail(err)
+			}
+		case "result":
+			tok, err := s.dec.Token()
+			if err != nil {
+				return s.fail(err)
+			}
+			switch tok {
+			case json.Delim('['):
+				s.inResult = true
+				return nil
+			case nil:
+			default:
+				return s.fail(fmt.Errorf("result is %v, not an array", tok))
+			}
+		default:
+			var skip json.RawMessage
+			if err := s.dec.Decode(&skip); err != nil {
+				return s.fail(err)
+			}
+		}
+	}
+	return s.expect(json.Delim('}'))
+}
+
+func (s *ListStream[T]) expect(want json.Delim) error {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return s.fail(err)
+	}
+	if tok != want {
+		return s.fail(fmt.Errorf("got %v, want %v", tok, want))
+	}
+	return nil
+}
+
+func (s *ListStream[T]) fail(err error) error {
+	return fmt.Errorf("cloapi: decode list response: %w", err)
+}
+
+// Count is the total the API reported, which for a paged call may exceed the
+// items in this response. It is 0 until the count field has been read.
+func (s *ListStream[T]) Count() int { return s.count }
+
+// Response is the HTTP response being decoded.
+func (s *ListStream[T]) Response() *http.Response { return s.rsp }
+
+// All yields the items in order. A decoding error is yielded once, with a zero
+// item, and ends the sequence. The body is closed when the sequence ends, also
+// when the loop breaks early. A stream can be iterated once only.
+func (s *ListStream[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer s.Close()
+		var zero T
+		if s.used {
+			yield(zero, errors.New("cloapi: list stream already consumed"))
+			return
+		}
+		s.used = true
+
+		for s.inResult {
+			for s.dec.More() {
+				// The envelope is walked with encoding/json's tokenizer; each item
+				// goes through the JSONCodec like any other body.
+				var raw json.RawMessage
+				if err := s.dec.Decode(&raw); err != nil {
+					yield(zero, s.fail(err))
+					return
+				}
+				var item T
+				if err := jsonCodec.Unmarshal(raw, &item); err != nil {
+					yield(zero, s.fail(err))
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+			s.inResult = false
+			// Close the array; more fields, count among them, may follow.
+			if err := s.expect(json.Delim(']')); err != nil {
+				yield(zero, err)
+				return
+			}
+			if err := s.fields(); err != nil {
+				yield(zero, err)
+				return
+			}
+		}
+	}
+}
+
+// Close releases the response body. It is safe to call more than once.
+func (s *ListStream[T]) Close() error {
+	return s.rsp.Body.Close()
+}
+
+// apiErrorOf builds the *ApiError of a failed response the way the generated
+// Parse*Response functions do.
+func apiErrorOf(rsp *http.Response, body []byte) *ApiError {
+	apiErr := &ApiError{Code: rsp.StatusCode}
+	if !strings.Contains(rsp.Header.Get("Content-Type"), "json") {
+		apiErr.Message = string(body)
+		return apiErr
+	}
+	if jsonCodec.Unmarshal(body, apiErr) != nil {
+		return &ApiError{Message: "failed to unmarshal error response", Description: string(body), Code: rsp.StatusCode}
+	}
+	return apiErr
+}
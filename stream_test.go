@@ -0,0 +1,167 @@
+package cloapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func statList(n int, countFirst bool) string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprintf(`{"addon":"cpu","measure":"core","param":"p%d","project_id":"2f1e4c6a-2a7b-4ef4-8a4b-61f0a3c5b0a1","statdate":"2024-01-01T00:00:00Z","value":%d}`, i, i)
+	}
+	result := `"result":[` + strings.Join(items, ",") + `]`
+	count := fmt.Sprintf(`"count":%d`, n)
+	if countFirst {
+		return `{` + count + `,"extra":{"a":[1]},` + result + `}`
+	}
+	return `{` + result + `,` + count + `}`
+}
+
+func streamServer(t *testing.T, status int, body string) *ClientWithResponses {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	cli, err := New("tok", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func TestStreamList(t *testing.T) {
+	for _, countFirst := range []bool{true, false} {
+		t.Run(fmt.Sprintf("countFirst=%v", countFirst), func(t *testing.T) {
+			cli := streamServer(t, http.StatusOK, statList(500, countFirst))
+			stream, err := StreamList[Stat](cli.AccountStat(context.Background()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if countFirst && stream.Count() != 500 {
+				t.Errorf("Count before iterating = %d, want 500", stream.Count())
+			}
+			n := 0
+			for stat, err := range stream.All() {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if stat.Param != fmt.Sprintf("p%d", n) {
+					t.Fatalf("item %d = %+v", n, stat)
+				}
+				n++
+			}
+			if n != 500 || stream.Count() != 500 {
+				t.Errorf("items = %d, Count = %d, want 500 both", n, stream.Count())
+			}
+		})
+	}
+}
+
+func TestStreamListUsesCodec(t *testing.T) {
+	codec := &countingCodec{}
+	SetJSONCodec(codec)
+	defer SetJSONCodec(nil)
+
+	cli := streamServer(t, http.StatusOK, statList(3, true))
+	stream, err := StreamList[Stat](cli.AccountStat(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range stream.All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := codec.unmarshal.Load(); got != 3 {
+		t.Errorf("codec decoded %d items, want 3", got)
+	}
+}
+
+func TestStreamListBreakAndReuse(t *testing.T) {
+	cli := streamServer(t, http.StatusOK, statList(10, true))
+	stream, err := StreamList[Stat](cli.AccountStat(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range stream.All() {
+		break
+	}
+	for _, err := range stream.All() {
+		if err == nil {
+			t.Error("second iteration yielded an item")
+		}
+	}
+}
+
+func TestStreamListErrors(t *testing.T) {
+	cli := streamServer(t, http.StatusForbidden, `{"code":403,"message":"denied"}`)
+	_, err := StreamList[Stat](cli.AccountStat(context.Background()))
+	if !HasStatus(err, http.StatusForbidden) {
+		t.Errorf("err = %v, want ApiError 403", err)
+	}
+
+	cli = streamServer(t, http.StatusOK, `{"count":2,"result":[{"value":1},{"value":"x"}]}`)
+	stream, err := StreamList[Stat](cli.AccountStat(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var items, errs int
+	for _, err := range stream.All() {
+		if err != nil {
+			errs++
+		} else {
+			items++
+		}
+	}
+	if items != 1 || errs != 1 {
+		t.Errorf("items = %d, errors = %d, want 1 and 1", items, errs)
+	}
+}
+
+func TestWithoutRawBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"code":404,"message":"gone"}`)
+			return
+		}
+		_, _ = io.WriteString(w, statList(3, true))
+	}))
+	defer srv.Close()
+	cli, err := New("tok", WithBaseURL(srv.URL), WithoutRawBody())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	resp, err := cli.AccountStatWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Body != nil || resp.OK == nil || len(resp.OK.Result) != 3 {
+		t.Errorf("Body = %q, OK = %+v; want no body, 3 items", resp.Body, resp.OK)
+	}
+
+	resp, err = cli.AccountStatWithResponse(WithCallRawBody(ctx, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Body) == 0 || resp.OK == nil {
+		t.Error("WithCallRawBody(true) did not keep the body")
+	}
+
+	resp, err = cli.AccountStatWithResponse(ctx, WithQuery(map[string]string{"fail": "1"}))
+	if !IsNotFound(err) || resp.Body != nil || resp.Error.Message != "gone" {
+		t.Errorf("err = %v, Body = %q", err, resp.Body)
+	}
+}
@@ -0,0 +1,222 @@
+package cloapi
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"log/slog"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DriftReport describes how a response body departs from the model it was
+// decoded into. Paths are dotted JSON paths from the body root, with [] standing
+// for every element of an array and * for every value of a map, e.g.
+// "result[].flavor.gpu". Each path is listed once however many elements share it.
+type DriftReport struct {
+	Method string
+	Path   string // URL path of the request
+	Model  string // Go type the body was decoded into, e.g. "PagServersSchema"
+	// Unknown are fields present in the body that the model does not declare.
+	// Their values are dropped by decoding.
+	Unknown []string
+	// Missing are required fields the body lacks, which decode as zero values.
+	Missing []string
+}
+
+func (r DriftReport) log(logger *slog.Logger) {
+	logger.LogAttrs(context.Background(), slog.LevelWarn, "cloapi: response drifts from spec",
+		slog.String("method", r.Method),
+		slog.String("path", r.Path),
+		slog.String("model", r.Model),
+		slog.Any("unknown", r.Unknown),
+		slog.Any("missing", r.Missing),
+	)
+}
+
+// checksDrift reports whether the call of rsp runs under WithStrictDecoding, in
+// which case its Parse*Response function must buffer the body.
+func checksDrift(rsp *http.Response) bool {
+	return rsp.Request != nil && callOptionsFrom(rsp.Request.Context()).drift != nil
+}
+
+// reportDrift compares body with the model ok was decoded into and passes any
+// drift to the call's WithStrictDecoding report.
+func reportDrift(rsp *http.Response, body []byte, ok any) {
+	if !checksDrift(rsp) {
+		return
+	}
+	var doc any
+	if json.Unmarshal(body, &doc) != nil {
+		return
+	}
+	t := reflect.TypeOf(ok)
+	d := &drift{spec: specValidation(), unknown: map[string]bool{}, missing: map[string]bool{}}
+	var schema map[string]any
+	if op, ok := MatchOperation(rsp.Request.Method, rsp.Request.URL.Path); ok {
+		schema = d.spec.responseSchema(op, rsp.StatusCode)
+	}
+	d.walk(t, schema, doc, "")
+	if len(d.unknown) == 0 && len(d.missing) == 0 {
+		return
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	callOptionsFrom(rsp.Request.Context()).drift(DriftReport{
+		Method:  rsp.Request.Method,
+		Path:    rsp.Request.URL.Path,
+		Model:   t.Name(),
+		Unknown: slices.Sorted(maps.Keys(d.unknown)),
+		Missing: slices.Sorted(maps.Keys(d.missing)),
+	})
+}
+
+// drift collects the paths found by walk.
+type drift struct {
+	spec             *specValidator // nil without an embedded spec
+	unknown, missing map[string]bool
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// walk compares the decoded JSON value v with type t and, if known, the spec
+// schema of the same value. Required fields come from the schema when it has
+// them, else from the model. As in encoding/json, body keys match field names
+// case-insensitively.
+func (d *drift) walk(t reflect.Type, schema map[string]any, v any, path string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	// Types that decode themselves (unions, times, UUIDs) are opaque here.
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+	if d.spec != nil {
+		schema = d.spec.resolve(schema)
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return
+		}
+		fields := modelFields(t)
+		props, required, fromSpec := d.spec.objectShape(schema)
+		for key, val := range obj {
+			name, f, ok := fieldFor(fields, key)
+			if !ok {
+				d.unknown[joinPath(path, key)] = true
+				continue
+			}
+			d.walk(f.typ, jsonObject(props[name]), val, joinPath(path, key))
+		}
+		if !fromSpec {
+			for name, f := range fields {
+				if f.required {
+					required = append(required, name)
+				}
+			}
+		}
+		for _, name := range required {
+			if _, ok := member(obj, name); !ok {
+				d.missing[joinPath(path, name)] = true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if arr, ok := v.([]any); ok {
+			for _, el := range arr {
+				d.walk(t.Elem(), jsonObject(schema["items"]), el, path+"[]")
+			}
+		}
+	case reflect.Map:
+		if obj, ok := v.(map[string]any); ok {
+			for _, val := range obj {
+				d.walk(t.Elem(), jsonObject(schema["additionalProperties"]), val, joinPath(path, "*"))
+			}
+		}
+	}
+}
+
+// fieldFor returns the field a body key decodes into: the one of that name, or
+// else one whose name matches it case-insensitively, as encoding/json does.
+func fieldFor(fields map[string]modelField, key string) (string, modelField, bool) {
+	if f, ok := fields[key]; ok {
+		return key, f, true
+	}
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		if strings.EqualFold(name, key) {
+			return name, fields[name], true
+		}
+	}
+	return "", modelField{}, false
+}
+
+// member returns the value of the key of obj that decodes into field name,
+// matched as fieldFor does.
+func member(obj map[string]any, name string) (any, bool) {
+	if v, ok := obj[name]; ok {
+		return v, true
+	}
+	for key, v := range obj {
+		if strings.EqualFold(key, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// modelField is a struct field as the JSON decoder sees it.
+type modelField struct {
+	typ reflect.Type
+	// required mirrors how the generator renders the spec: a required property
+	// becomes a non-pointer field without omitempty. Only a fallback for when
+	// the spec itself is not embedded.
+	required bool
+}
+
+var modelFieldCache sync.Map // reflect.Type -> map[string]modelField
+
+// modelFields returns the JSON fields of struct type t by name.
+func modelFields(t reflect.Type) map[string]modelField {
+	if fields, ok := modelFieldCache.Load(t); ok {
+		return fields.(map[string]modelField)
+	}
+	fields := map[string]modelField{}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			maps.Copy(fields, modelFields(f.Type))
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		omitempty := slices.Contains(strings.Split(opts, ","), "omitempty")
+		fields[name] = modelField{typ: f.Type, required: !omitempty && f.Type.Kind() != reflect.Pointer}
+	}
+	modelFieldCache.Store(t, fields)
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
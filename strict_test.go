@@ -0,0 +1,148 @@
+package cloapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// driftedServers is a two-server listing where each server carries an
+// undeclared "gpu" and the second lacks the required "status".
+func driftedServers(t *testing.T) []byte {
+	t.Helper()
+	var doc map[string]any
+	if err := json.Unmarshal(serversPayload(2), &doc); err != nil {
+		t.Fatal(err)
+	}
+	servers := doc["result"].([]any)
+	for _, s := range servers {
+		s.(map[string]any)["gpu"] = map[string]any{"model": "a100"}
+	}
+	delete(servers[1].(map[string]any), "status")
+	servers[0].(map[string]any)["flavor"].(map[string]any)["numa"] = 2
+	b, _ := json.Marshal(doc)
+	return b
+}
+
+func driftServer(t *testing.T, body []byte) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestStrictDecoding(t *testing.T) {
+	withoutSpec(t)
+	var reports []DriftReport
+	cli, err := New("tok", WithBaseURL(driftServer(t, driftedServers(t))), WithoutRawBody(),
+		WithStrictDecoding(func(r DriftReport) { reports = append(reports, r) }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := cli.ProjectServerListWithResponse(context.Background(), "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.OK == nil || len(*resp.OK.Result) != 2 || resp.Body != nil {
+		t.Fatalf("response not decoded as usual: OK = %v, Body = %d bytes", resp.OK != nil, len(resp.Body))
+	}
+	if len(reports) != 1 {
+		t.Fatalf("reports = %d, want 1", len(reports))
+	}
+	r := reports[0]
+	if r.Method != http.MethodGet || r.Path != "/v2/projects/p1/servers" || r.Model != "PagServersSchema" {
+		t.Errorf("report = %+v", r)
+	}
+	if want := []string{"result[].flavor.numa", "result[].gpu"}; !slices.Equal(r.Unknown, want) {
+		t.Errorf("Unknown = %q, want %q", r.Unknown, want)
+	}
+	if want := []string{"result[].status"}; !slices.Equal(r.Missing, want) {
+		t.Errorf("Missing = %q, want %q", r.Missing, want)
+	}
+}
+
+func TestStrictDecodingUsesSpec(t *testing.T) {
+	withSpec(t, `{"paths": {"/v2/projects/{object_id}/servers": {"get": {"responses": {"200": {"content": {"application/json": {
+		"schema": {"$ref": "#/components/schemas/PagServersSchema"}}}}}}}},
+	"components": {"schemas": {
+		"PagServersSchema": {"properties": {"result": {"type": "array", "items": {"$ref": "#/components/schemas/ServerSchema"}}}},
+		"ServerSchema": {"allOf": [{"required": ["id"]}, {"required": ["primary_address"]}]}
+	}}}`)
+	var doc map[string]any
+	if err := json.Unmarshal(serversPayload(2), &doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range doc["result"].([]any) {
+		s := s.(map[string]any)
+		s["Name"] = s["name"] // encoding/json decodes it into Name all the same
+		delete(s, "name")
+	}
+	second := doc["result"].([]any)[1].(map[string]any)
+	delete(second, "status")          // required by the model's shape, not by the spec
+	delete(second, "primary_address") // a pointer in the model, required by the spec
+	body, _ := json.Marshal(doc)
+
+	var reports []DriftReport
+	cli, err := New("tok", WithBaseURL(driftServer(t, body)), WithStrictDecoding(func(r DriftReport) { reports = append(reports, r) }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.ProjectServerListWithResponse(context.Background(), "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 || len(reports[0].Unknown) != 0 || !slices.Equal(reports[0].Missing, []string{"result[].primary_address"}) {
+		t.Errorf("reports = %+v, want only result[].primary_address missing", reports)
+	}
+}
+
+func TestStrictDecodingClean(t *testing.T) {
+	called := false
+	cli, err := New("tok", WithBaseURL(driftServer(t, serversPayload(3))),
+		WithStrictDecoding(func(DriftReport) { called = true }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.ProjectServerListWithResponse(context.Background(), "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if called {
+		t.Error("a body matching its model was reported")
+	}
+}
+
+func TestStrictDecodingLogs(t *testing.T) {
+	var logs bytes.Buffer
+	cli, err := New("tok", WithBaseURL(driftServer(t, driftedServers(t))), WithStrictDecoding(nil),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.ProjectServerListWithResponse(context.Background(), "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if out := logs.String(); !strings.Contains(out, "level=WARN") || !strings.Contains(out, "result[].gpu") {
+		t.Errorf("log = %q", out)
+	}
+}
+
+func TestParseWithoutClientSkipsDrift(t *testing.T) {
+	rsp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(driftedServers(t))),
+	}
+	resp, err := ParseProjectServerListResponse(rsp)
+	if err != nil || resp.OK == nil || len(resp.Body) == 0 {
+		t.Fatalf("err = %v", err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 // LoggingRoundTripper logs every HTTP request/response via slog. Written once,
@@ -24,9 +26,15 @@ func (l *LoggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, erro
 		slog.Duration("duration", duration),
 	}
 
+	override := callOptionsFrom(req.Context()).logLevel
+
 	if err != nil {
 		attrs = append(attrs, slog.String("error", err.Error()))
-		l.Logger.LogAttrs(req.Context(), slog.LevelError, "HTTP request failed", attrs...)
+		level := slog.LevelError
+		if override != nil {
+			level = *override
+		}
+		l.Logger.LogAttrs(req.Context(), level, "HTTP request failed", attrs...)
 		return nil, err
 	}
 
@@ -39,6 +47,22 @@ func (l *LoggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, erro
 	case resp.StatusCode >= 400:
 		level = slog.LevelWarn
 	}
+	if override != nil {
+		level = *override
+	}
+	// Sizes of a compressed body are known once it has been read, so its line is
+	// logged then.
+	if body, ok := resp.Body.(*decodedBody); ok {
+		body.report = func(body *decodedBody) {
+			attrs = append(attrs,
+				slog.String("content_encoding", body.encoding),
+				slog.Int64("wire_bytes", body.wire.n),
+				slog.Int64("bytes", body.size),
+			)
+			l.Logger.LogAttrs(req.Context(), level, "HTTP request processed", attrs...)
+		}
+		return resp, nil
+	}
 	l.Logger.LogAttrs(req.Context(), level, "HTTP request processed", attrs...)
 
 	return resp, nil
@@ -75,13 +99,17 @@ func isRetryableStatus(code int) bool {
 }
 
 func (rt *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	if rt.Config.Count <= 0 || !isIdempotent(req.Method) {
+	cfg := rt.Config
+	if o := callOptionsFrom(req.Context()).retry; o != nil {
+		cfg = *o
+	}
+	if cfg.Count <= 0 || !isIdempotent(req.Method) {
 		return rt.Proxied.RoundTrip(req)
 	}
 
 	var resp *http.Response
 	var err error
-	for attempt := 0; attempt <= rt.Config.Count; attempt++ {
+	for attempt := 0; attempt <= cfg.Count; attempt++ {
 		if attempt > 0 {
 			// Rewind the body for replay; bail out if it can't be rewound.
 			if req.Body != nil {
@@ -94,9 +122,9 @@ func (rt *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error
 				}
 				req.Body = body
 			}
-			if rt.Config.Backoff > 0 {
+			if cfg.Backoff > 0 {
 				select {
-				case <-time.After(rt.Config.Backoff):
+				case <-time.After(cfg.Backoff):
 				case <-req.Context().Done():
 					return nil, req.Context().Err()
 				}
@@ -111,13 +139,27 @@ func (rt *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error
 			return resp, nil
 		}
 		// Retryable status: drain+close before the next attempt (unless it's the last).
-		if attempt < rt.Config.Count {
+		if attempt < cfg.Count {
 			drainAndClose(resp)
 		}
 	}
 	return resp, err
 }
 
+// rateLimitRoundTripper holds each request until the limiter admits it, so a
+// busy job stays under the API's quota instead of collecting 429s.
+type rateLimitRoundTripper struct {
+	Proxied http.RoundTripper
+	Limiter *rate.Limiter
+}
+
+func (rl *rateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := rl.Limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return rl.Proxied.RoundTrip(req)
+}
+
 func drainAndClose(resp *http.Response) {
 	if resp == nil || resp.Body == nil {
 		return
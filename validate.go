@@ -0,0 +1,187 @@
+package cloapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ValidateRequestBody checks a JSON request body against the request schema of
+// the operation named operationID. Violations are returned as a
+// *ValidationError whose Fields are JSON pointers into body, e.g.
+// "/healthmonitor/type". Fields the schema does not declare are not checked.
+//
+// The body is checked against the embedded spec (see OpenAPISpec): types,
+// required fields, nullability, enums, minimum and maximum, minLength and
+// maxLength, pattern, format and item counts. In a checkout without a spec
+// snapshot it is checked against the generated model instead, which only
+// carries the required fields, the JSON types and the enums of typed fields.
+//
+// Operations without a JSON request body accept any body.
+func ValidateRequestBody(operationID string, body []byte) error {
+	op, ok := OperationByID(operationID)
+	if !ok {
+		return fmt.Errorf("cloapi: validate: unknown operation %q", operationID)
+	}
+	return validateBody(op, body)
+}
+
+func validateBody(op Operation, body []byte) error {
+	spec := specValidation()
+	schema, fromSpec := spec.bodySchema(op)
+	if !fromSpec && op.newBody == nil {
+		return nil
+	}
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return &ValidationError{Fields: []*FieldError{{Field: "", Reason: "body is not valid JSON: " + err.Error()}}}
+	}
+	v := &ValidationError{}
+	if fromSpec {
+		spec.validate(v, schema, doc, "")
+	} else {
+		validateValue(v, reflect.TypeOf(op.newBody()).Elem(), doc, "")
+	}
+	return v.errOrNil()
+}
+
+var enumType = reflect.TypeFor[interface{ Valid() bool }]()
+
+// validateValue checks the decoded JSON value doc against type t at pointer ptr,
+// the fallback when no spec is embedded.
+func validateValue(v *ValidationError, t reflect.Type, doc any, ptr string) {
+	if doc == nil {
+		if t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface && t.Kind() != reflect.Slice && t.Kind() != reflect.Map {
+			v.add(ptr, "must not be null")
+		}
+		return
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	// Enums, unions, times and UUIDs: decode the value and let the type judge.
+	if t.Implements(enumType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		raw, _ := json.Marshal(doc)
+		val := reflect.New(t)
+		if err := json.Unmarshal(raw, val.Interface()); err != nil {
+			v.add(ptr, "invalid value: "+err.Error())
+			return
+		}
+		if e, ok := val.Elem().Interface().(interface{ Valid() bool }); ok && !e.Valid() {
+			v.add(ptr, fmt.Sprintf("%s is not a valid %s", raw, t.Name()))
+		}
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			v.add(ptr, "must be an object")
+			return
+		}
+		fields := modelFields(t)
+		for _, name := range slices.Sorted(maps.Keys(fields)) {
+			f := fields[name]
+			val, present := member(obj, name)
+			switch {
+			case present:
+				validateValue(v, f.typ, val, ptr+"/"+escapePointer(name))
+			case f.required:
+				v.add(ptr+"/"+escapePointer(name), "required field is missing")
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := doc.([]any)
+		if !ok {
+			v.add(ptr, "must be an array")
+			return
+		}
+		for i, el := range arr {
+			validateValue(v, t.Elem(), el, ptr+"/"+strconv.Itoa(i))
+		}
+	case reflect.Map:
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			v.add(ptr, "must be an object")
+			return
+		}
+		for _, key := range slices.Sorted(maps.Keys(obj)) {
+			validateValue(v, t.Elem(), obj[key], ptr+"/"+escapePointer(key))
+		}
+	case reflect.String:
+		if _, ok := doc.(string); !ok {
+			v.add(ptr, "must be a string")
+		}
+	case reflect.Bool:
+		if _, ok := doc.(bool); !ok {
+			v.add(ptr, "must be a boolean")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, ok := doc.(float64); !ok || n != math.Trunc(n) {
+			v.add(ptr, "must be an integer")
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := doc.(float64); !ok {
+			v.add(ptr, "must be a number")
+		}
+	}
+}
+
+// escapePointer escapes a member name for a JSON pointer (RFC 6901).
+func escapePointer(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
+
+// validateRoundTripper checks JSON request bodies with validateBody before they
+// leave the client, so a bad body fails locally instead of with a 400.
+type validateRoundTripper struct {
+	Proxied http.RoundTripper
+}
+
+func (t *validateRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.Body == http.NoBody || !strings.Contains(req.Header.Get("Content-Type"), "json") {
+		return t.Proxied.RoundTrip(req)
+	}
+	op, ok := MatchOperation(req.Method, req.URL.Path)
+	if _, fromSpec := specValidation().bodySchema(op); !ok || (op.newBody == nil && !fromSpec) {
+		return t.Proxied.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		body, err = io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// The body can be read once only: send a copy.
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if err := validateBody(op, body); err != nil {
+		return nil, err
+	}
+	return t.Proxied.RoundTrip(req)
+}
@@ -0,0 +1,102 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func fieldsOf(t *testing.T, err error) []string {
+	t.Helper()
+	var v *ValidationError
+	if !errors.As(err, &v) {
+		t.Fatalf("err = %v, want *ValidationError", err)
+	}
+	var fields []string
+	for _, f := range v.Fields {
+		fields = append(fields, f.Field+": "+f.Reason)
+	}
+	return fields
+}
+
+func TestValidateRequestBody(t *testing.T) {
+	withoutSpec(t)
+	body := []byte(`{
+		"name": "lb",
+		"algorithm": "FASTEST",
+		"healthmonitor": {"delay": 5, "max_retries": "3", "type": "HTTP"},
+		"rules": [{"address_id": "a", "external_protocol_port": 80}],
+		"session_persistence": null
+	}`)
+	got := fieldsOf(t, ValidateRequestBody("LoadBalancerCreate", body))
+	want := []string{
+		`/algorithm: "FASTEST" is not a valid LoadBalancerCreateJSONBodyAlgorithm`,
+		"/healthmonitor/max_retries: must be an integer",
+		"/healthmonitor/timeout: required field is missing",
+		"/rules/0/internal_protocol_port: required field is missing",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("violations:\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestValidateRequestBodyValid(t *testing.T) {
+	withoutSpec(t)
+	body := []byte(`{"name":"lb","algorithm":"ROUND_ROBIN","healthmonitor":{"delay":5,"max_retries":3,"timeout":2,"type":"TCP"}}`)
+	if err := ValidateRequestBody("LoadBalancerCreate", body); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateRequestBody("ServerReboot", []byte(`{"anything":1}`)); err != nil {
+		t.Errorf("operation without body schema: %v", err)
+	}
+	if err := ValidateRequestBody("NoSuchOperation", nil); err == nil {
+		t.Error("unknown operation accepted")
+	}
+	if got := fieldsOf(t, ValidateRequestBody("LoadBalancerCreate", []byte(`{`))); len(got) != 1 {
+		t.Errorf("invalid JSON: %q", got)
+	}
+}
+
+func TestWithRequestValidation(t *testing.T) {
+	withoutSpec(t)
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"result":{"id":"lb1"}}`))
+	}))
+	defer srv.Close()
+	cli, err := New("tok", WithBaseURL(srv.URL), WithRequestValidation())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// The zero enum value of a typed body is caught.
+	body := LoadBalancerCreateJSONRequestBody{Name: "lb"}
+	_, err = cli.LoadBalancerCreateWithResponse(ctx, "p1", body)
+	if got := fieldsOf(t, err); !slices.Equal(got, []string{`/healthmonitor/type: "" is not a valid LoadBalancerCreateJSONBodyHealthmonitorType`}) {
+		t.Errorf("violations = %q", got)
+	}
+
+	// So is a raw body sent through Invoke.
+	_, err = cli.Invoke(ctx, "LoadBalancerCreate", map[string]string{"object_id": "p1"}, []byte(`{"name":1}`))
+	if len(fieldsOf(t, err)) == 0 {
+		t.Error("no violations")
+	}
+	if calls.Load() != 0 {
+		t.Fatalf("invalid bodies reached the API %d times", calls.Load())
+	}
+
+	body.Healthmonitor.Type = LoadBalancerCreateJSONBodyHealthmonitorTypeTCP
+	if _, err := cli.LoadBalancerCreateWithResponse(ctx, "p1", body); err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+}
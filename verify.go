@@ -0,0 +1,91 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// AuthError reports that the API rejected the token (401) or refused it access to
+// everything Verify probes (403). It unwraps to the underlying *ApiError.
+type AuthError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *AuthError) Error() string {
+	if e.StatusCode == http.StatusForbidden {
+		return fmt.Sprintf("cloapi: token is not permitted to access the API: %v", e.Err)
+	}
+	return fmt.Sprintf("cloapi: token rejected, check that it is valid and not expired: %v", e.Err)
+}
+
+func (e *AuthError) Unwrap() error { return e.Err }
+
+// IsAuthError reports whether err is an *AuthError or an API error with status
+// 401, i.e. whether a new token is needed.
+func IsAuthError(err error) bool {
+	var authErr *AuthError
+	return errors.As(err, &authErr) || HasStatus(err, http.StatusUnauthorized)
+}
+
+// Identity summarises what a token can see, as probed by Verify.
+type Identity struct {
+	// Projects is the number of projects visible to the token.
+	Projects int
+	// BalanceReadable is false for tokens scoped away from account billing.
+	BalanceReadable bool
+	// Balance is the account balance when BalanceReadable.
+	Balance *AccountBalanceSchema
+}
+
+// Verify checks the client's token against two cheap endpoints, the project list
+// (one item) and the account balance, and reports what the token can see:
+//
+//	id, err := cloapi.Verify(ctx, cli)
+//	if cloapi.IsAuthError(err) {
+//		log.Fatalf("CLO token is invalid: %v", err)
+//	}
+//
+// A 401 from either endpoint, or a 403 from both, is returned as *AuthError. Other
+// failures, such as an unreachable API, are wrapped but not classified as auth.
+func Verify(ctx context.Context, cli ClientWithResponsesInterface) (*Identity, error) {
+	id := &Identity{}
+
+	projects, projErr := cli.ProjectListWithResponse(ctx, WithPage(1, 0))
+	switch {
+	case projErr == nil:
+		if projects.OK != nil {
+			id.Projects = projects.OK.Count
+		}
+	case HasStatus(projErr, http.StatusForbidden):
+		// The token may still be good for billing; decide after the balance probe.
+	default:
+		return nil, verifyError(projErr)
+	}
+
+	balance, balErr := cli.AccountBalanceWithResponse(ctx)
+	switch {
+	case balErr == nil:
+		id.BalanceReadable = true
+		if balance.OK != nil {
+			id.Balance = balance.OK.Result
+		}
+	case HasStatus(balErr, http.StatusForbidden):
+		if projErr != nil {
+			return nil, verifyError(projErr)
+		}
+	default:
+		return nil, verifyError(balErr)
+	}
+	return id, nil
+}
+
+// verifyError turns a 401 or 403 into *AuthError and wraps anything else.
+func verifyError(err error) error {
+	if apiErr, ok := AsApiError(err); ok && (apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden) {
+		return &AuthError{StatusCode: apiErr.Code, Err: err}
+	}
+	return fmt.Errorf("cloapi: verify token: %w", err)
+}
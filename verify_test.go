@@ -0,0 +1,93 @@
+package cloapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// probeServer answers the two endpoints Verify calls with the given statuses.
+func probeServer(t *testing.T, projects, balance int) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/projects":
+			w.WriteHeader(projects)
+			if projects == http.StatusOK {
+				_, _ = w.Write([]byte(`{"count":7,"result":[]}`))
+				return
+			}
+		case "/v2/balance":
+			w.WriteHeader(balance)
+			if balance == http.StatusOK {
+				_, _ = w.Write([]byte(`{"result":{"balance":42.5,"credit_limit":0,"cost":{},"cost_active":{}}}`))
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte(`{"message":"nope"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestVerify(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		projects, balance int
+		wantAuth          bool
+		wantErr           bool
+		wantProjects      int
+		wantBalance       bool
+	}{
+		{name: "full access", projects: 200, balance: 200, wantProjects: 7, wantBalance: true},
+		{name: "no billing", projects: 200, balance: 403, wantProjects: 7},
+		{name: "billing only", projects: 403, balance: 200, wantBalance: true},
+		{name: "forbidden everywhere", projects: 403, balance: 403, wantAuth: true, wantErr: true},
+		{name: "bad token", projects: 401, balance: 401, wantAuth: true, wantErr: true},
+		{name: "outage", projects: 503, balance: 200, wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cli, err := New("tok", WithBaseURL(probeServer(t, tc.projects, tc.balance)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			id, err := Verify(context.Background(), cli)
+			if (err != nil) != tc.wantErr || IsAuthError(err) != tc.wantAuth {
+				t.Fatalf("err = %v, wantErr %v, wantAuth %v", err, tc.wantErr, tc.wantAuth)
+			}
+			if err != nil {
+				return
+			}
+			if id.Projects != tc.wantProjects || id.BalanceReadable != tc.wantBalance {
+				t.Fatalf("identity = %+v", id)
+			}
+			if tc.wantBalance && id.Balance.Balance != 42.5 {
+				t.Fatalf("balance = %+v", id.Balance)
+			}
+		})
+	}
+}
+
+func TestWithVerifyOnStart(t *testing.T) {
+	url := probeServer(t, 401, 401)
+	if _, err := New("tok", WithBaseURL(url)); err != nil {
+		t.Fatalf("New without verification must not call the API: %v", err)
+	}
+	_, err := New("tok", WithBaseURL(url), WithVerifyOnStart())
+	var authErr *AuthError
+	if !errors.As(err, &authErr) || authErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("err = %v, want *AuthError 401", err)
+	}
+	if _, ok := AsApiError(err); !ok {
+		t.Error("AuthError should unwrap to *ApiError")
+	}
+
+	if _, err := New("tok", WithBaseURL(probeServer(t, 200, 200)), WithVerifyOnStart()); err != nil {
+		t.Fatal(err)
+	}
+}